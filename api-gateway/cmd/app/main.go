@@ -13,12 +13,18 @@ import (
 func main() {
 	cfg := config.MustLoad()
 
-	application := app.New(cfg.Serv.HTTP.Host, cfg.GRPC.DBService.Address)
+	application := app.New(cfg.Serv.HTTP.Host, cfg.Serv.Metrics.Host, cfg.GRPC.DBService)
 
 	go func() {
 		application.Server.MustRun()
 	}()
 
+	if application.Metrics != nil {
+		go func() {
+			application.Metrics.MustRun()
+		}()
+	}
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 
@@ -28,4 +34,8 @@ func main() {
 	defer cancel()
 
 	application.Server.Stop(ctx)
+
+	if application.Metrics != nil {
+		application.Metrics.Stop(ctx)
+	}
 }
@@ -1,6 +1,10 @@
 package app
 
 import (
+	"expvar"
+	"log/slog"
+	"net/http"
+	"todo/api/internal/config"
 	"todo/api/internal/grpc/client"
 	"todo/api/internal/http/handlers"
 	"todo/api/internal/http/router"
@@ -10,10 +14,12 @@ import (
 
 type App struct {
 	Server *server.Server
+	// Metrics serves /debug/vars apart from the public API. It is nil when disabled.
+	Metrics *server.Server
 }
 
-func New(serverAddr string, serviceAddr string) *App {
-	grpclient, err := client.New(serviceAddr)
+func New(serverAddr, metricsAddr string, dbService config.DBService) *App {
+	grpclient, err := client.New(dbService.Address, dbService.Timeout, dbService.Retry, slog.Default())
 	if err != nil {
 		panic("grpc server not connected")
 	}
@@ -23,11 +29,20 @@ func New(serverAddr string, serviceAddr string) *App {
 		"tasks",
 	)
 
-	handlers := handlers.New(grpclient, writer)
+	handlers := handlers.New(grpclient, grpclient, grpclient, writer)
 	router := router.New(handlers).InitRouter()
 	app := server.New(serverAddr, router)
 
+	var metrics *server.Server
+
+	if metricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/vars", expvar.Handler())
+		metrics = server.New(metricsAddr, mux)
+	}
+
 	return &App{
-		Server: app,
+		Server:  app,
+		Metrics: metrics,
 	}
 }
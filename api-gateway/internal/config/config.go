@@ -15,7 +15,13 @@ type Config struct {
 }
 
 type Server struct {
-	HTTP HTTP `yaml:"http"`
+	HTTP    HTTP    `yaml:"http"`
+	Metrics Metrics `yaml:"metrics"`
+}
+
+// Metrics is the internal listener serving /debug/vars. An empty Host disables it.
+type Metrics struct {
+	Host string `yaml:"host"`
 }
 
 type HTTP struct {
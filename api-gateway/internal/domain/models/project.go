@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+const (
+	DeleteModeArchive = "archive"
+	DeleteModeCascade = "cascade"
+)
+
+type Project struct {
+	Id          int64      `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ArchivedAt  *time.Time `json:"archived_at"`
+}
@@ -1,12 +1,151 @@
 package models
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	PriorityNone   = "none"
+	PriorityLow    = "low"
+	PriorityMedium = "medium"
+	PriorityHigh   = "high"
+	PriorityUrgent = "urgent"
+)
+
+const (
+	StatusTodo       = "todo"
+	StatusInProgress = "in_progress"
+	StatusReview     = "review"
+	StatusDone       = "done"
+	StatusCancelled  = "cancelled"
+)
 
 type Task struct {
-	Id          int64      `json:"id"`
-	Name        string     `json:"name"`
-	Description string     `json:"description"`
-	Completed   bool       `json:"completed"`
-	CreatedAt   time.Time  `json:"created_at"`
-	CompletedAt *time.Time `json:"completed_at"`
+	Id              int64      `json:"id"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	Completed       bool       `json:"completed"`
+	Status          string     `json:"status"`
+	StatusChangedAt *time.Time `json:"status_changed_at"`
+	Priority        string     `json:"priority"`
+	CreatedAt       time.Time  `json:"created_at"`
+	CompletedAt     *time.Time `json:"completed_at"`
+	DueAt           *time.Time `json:"due_at"`
+	Overdue         bool       `json:"overdue"`
+	Tags            []string   `json:"tags"`
+	ParentId        *int64     `json:"parent_id"`
+	Subtasks        []Task     `json:"subtasks,omitempty"`
+	Recurrence      string     `json:"recurrence"`
+	NextDueAt       *time.Time `json:"next_occurrence_at"`
+	ProjectId       *int64     `json:"project_id"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+	ArchivedAt      *time.Time `json:"archived_at,omitempty"`
+	Version         int64      `json:"version"`
+}
+
+// TaskPage is one page of a paginated task list. NextCursor is empty on the last page.
+type TaskPage struct {
+	Items      []Task `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// SearchHit is a task found by full-text search. Snippet is an HTML-escaped excerpt of the
+// description, or the title when only the title matches, with the matching words wrapped in <b></b>.
+type SearchHit struct {
+	Task    Task    `json:"task"`
+	Rank    float64 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+// SortKey orders a task list by one field.
+type SortKey struct {
+	Field string
+	Desc  bool
+}
+
+// TaskQuery filters, sorts and paginates the task list. Zero fields don't filter.
+type TaskQuery struct {
+	Q             string
+	Completed     *bool
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Filter        string
+	Sort          []SortKey
+	Limit         int32
+	Cursor        string
+}
+
+// FilterError is a rejected filter expression. Pos and End are the rune offsets of the
+// offending span, End exclusive.
+type FilterError struct {
+	Pos     int
+	End     int
+	Message string
+}
+
+func (e *FilterError) Error() string {
+	return fmt.Sprintf("invalid filter: %s at offset %d", e.Message, e.Pos)
+}
+
+// ErrVersionMismatch is returned by a write conditioned on a task version that is no longer current.
+var ErrVersionMismatch = errors.New("task was modified by someone else")
+
+// ErrIdempotencyMismatch is returned when an idempotency key is reused for a different request.
+var ErrIdempotencyMismatch = errors.New("idempotency key was already used for a different request")
+
+type Tag struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+var tagPattern = regexp.MustCompile(`^[\p{L}\p{N}_-]{1,64}$`)
+
+func ValidStatus(s string) bool {
+	switch s {
+	case StatusTodo, StatusInProgress, StatusReview, StatusDone, StatusCancelled:
+		return true
+	}
+
+	return false
+}
+
+// ClosedStatus reports whether s is a status a task ends in, so it can no longer be overdue.
+func ClosedStatus(s string) bool {
+	return s == StatusDone || s == StatusCancelled
+}
+
+// NormalizeTag lowercases and trims a tag name, reporting whether the result is a valid tag.
+func NormalizeTag(tag string) (string, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+
+	return tag, tagPattern.MatchString(tag)
+}
+
+// NestSubtasks arranges a flat list of descendants into a tree and returns the direct children of parentID.
+func NestSubtasks(parentID int64, tasks []Task) []Task {
+	children := make(map[int64][]Task)
+
+	for _, t := range tasks {
+		if t.ParentId != nil {
+			children[*t.ParentId] = append(children[*t.ParentId], t)
+		}
+	}
+
+	var nest func(id int64) []Task
+
+	nest = func(id int64) []Task {
+		nodes := children[id]
+
+		for i := range nodes {
+			nodes[i].Subtasks = nest(nodes[i].Id)
+		}
+
+		return nodes
+	}
+
+	return nest(parentID)
 }
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrViewExists = errors.New("a view with this name already exists")
+
+// View is a saved task list query that belongs to an owner. Criteria and Sort use the same
+// names and syntax as the GET /todos parameters.
+type View struct {
+	Id        int64        `json:"id"`
+	Owner     string       `json:"owner"`
+	Name      string       `json:"name"`
+	Criteria  ViewCriteria `json:"criteria"`
+	Sort      string       `json:"sort"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type ViewCriteria struct {
+	Q             string     `json:"q,omitempty"`
+	Completed     *bool      `json:"completed,omitempty"`
+	CreatedAfter  *time.Time `json:"created_after,omitempty"`
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+	Filter        string     `json:"filter,omitempty"`
+}
@@ -3,213 +3,742 @@ package client
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
 	"time"
+	"todo/api/internal/config"
 	"todo/api/internal/domain/models"
 	dbpb "todo/proto/db/gen"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	priorityPrefix = "PRIORITY_"
+	statusPrefix   = "TASK_STATUS_"
 )
 
 type Client struct {
-	client dbpb.TaskServiceClient
+	client   dbpb.TaskServiceClient
+	projects dbpb.ProjectServiceClient
+	views    dbpb.ViewServiceClient
 }
 
-func New(addr string) (*Client, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// New connects to db-service. Every attempt of a call is bounded by timeout, on top of the
+// deadline of the context it is made with, and failed reads are retried as retry describes.
+func New(addr string, timeout time.Duration, retry config.Retry, log *slog.Logger) (*Client, error) {
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			retryInterceptor(retry, log),
+			timeoutInterceptor(timeout),
+		),
+	)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to gRPC server: %w", err)
 	}
 
-	newClient := dbpb.NewTaskServiceClient(conn)
-
 	return &Client{
-		client: newClient,
+		client:   dbpb.NewTaskServiceClient(conn),
+		projects: dbpb.NewProjectServiceClient(conn),
+		views:    dbpb.NewViewServiceClient(conn),
 	}, nil
 }
 
-func (c *Client) CreateTask(name, description string) error {
+// CreateTask creates a task and returns it. A retry with the same idempotency key returns the
+// task the first request created, with replayed set.
+func (c *Client) CreateTask(ctx context.Context, task models.Task, key string) (created models.Task, replayed bool, err error) {
 	const op = "client.CreateTask"
 
-	_, err := c.client.CreateTask(context.Background(), &dbpb.TaskRequest{
-		Title:       name,
-		Description: description,
-		Completed:   false,
+	resp, err := c.client.CreateTask(ctx, &dbpb.TaskRequest{
+		Title:          task.Name,
+		Description:    task.Description,
+		Completed:      false,
+		Priority:       toPriority(task.Priority),
+		DueAt:          toTimestamp(task.DueAt),
+		ParentId:       task.ParentId,
+		Recurrence:     task.Recurrence,
+		ProjectId:      task.ProjectId,
+		IdempotencyKey: key,
 	})
 
 	if err != nil {
-		return fmt.Errorf("%s: %v", op, err)
+		if errorInfo(err, "IDEMPOTENCY_KEY_REUSED") != nil {
+			return models.Task{}, false, fmt.Errorf("%s: %w: %w", op, models.ErrIdempotencyMismatch, err)
+		}
+
+		return models.Task{}, false, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
+	return toModel(resp.Task), resp.Replayed, nil
 }
 
-func (c *Client) GetTask(id int64) (models.Task, error) {
+func (c *Client) GetTask(ctx context.Context, id int64) (models.Task, error) {
 	const op = "client.GetTask"
 
-	task, err := c.client.GetTask(context.Background(), &dbpb.TaskId{
+	task, err := c.client.GetTask(ctx, &dbpb.TaskId{
 		Id: id,
 	})
 
 	if err != nil {
-		return models.Task{}, fmt.Errorf("%s: %v", op, err)
+		return models.Task{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	model := task.Task
+	return toModel(task.Task), nil
+}
 
-	var completedAt *time.Time
+func (c *Client) EditTask(ctx context.Context, task models.Task) (models.Task, error) {
+	const op = "client.EditTask"
+
+	resp, err := c.client.EditTask(ctx, &dbpb.EditTaskRequest{
+		Id:          task.Id,
+		Title:       task.Name,
+		Description: task.Description,
+		Priority:    toOptionalPriority(task.Priority),
+		DueAt:       toTimestamp(task.DueAt),
+		Recurrence:  task.Recurrence,
+		ProjectId:   task.ProjectId,
+		Version:     task.Version,
+	})
 
-	if model.CompletedAt != nil {
-		t := model.CompletedAt.AsTime()
-		completedAt = &t
+	if err != nil {
+		return models.Task{}, fmt.Errorf("%s: %w", op, taskError(err))
 	}
 
-	return models.Task{
-		Id:          model.Id,
-		Name:        model.Title,
-		Description: model.Description,
-		Completed:   model.Completed,
-		CreatedAt:   model.CreatedAt.AsTime(),
-		CompletedAt: completedAt,
-	}, nil
+	return toModel(resp.Task), nil
 }
 
-func (c *Client) EditTask(id int64, name, description string) error {
-	const op = "client.EditTask"
+func (c *Client) UpdateTask(ctx context.Context, task models.Task, fields []string) (models.Task, error) {
+	const op = "client.UpdateTask"
+
+	resp, err := c.client.UpdateTask(ctx, &dbpb.UpdateTaskRequest{
+		Task: &dbpb.TaskItem{
+			Id:          task.Id,
+			Title:       task.Name,
+			Description: task.Description,
+			Priority:    toPriority(task.Priority),
+			DueAt:       toTimestamp(task.DueAt),
+			Recurrence:  task.Recurrence,
+			ProjectId:   fromID(task.ProjectId),
+			Version:     task.Version,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: fields},
+	})
 
-	_, err := c.client.EditTask(context.Background(), &dbpb.EditTaskRequest{
-		Id:          id,
-		Title:       name,
-		Description: description,
+	if err != nil {
+		return models.Task{}, fmt.Errorf("%s: %w", op, taskError(err))
+	}
+
+	return toModel(resp.Task), nil
+}
+
+func (c *Client) DeleteTask(ctx context.Context, id, version int64) error {
+	const op = "client.DeleteTask"
+
+	_, err := c.client.DeleteTask(ctx, &dbpb.DeleteTaskRequest{
+		Id:      id,
+		Version: version,
 	})
 
 	if err != nil {
-		return fmt.Errorf("%s: %v", op, err)
+		return fmt.Errorf("%s: %w", op, taskError(err))
 	}
 
 	return nil
 }
 
-func (c *Client) DeleteTask(id int64) error {
-	const op = "client.DeleteTask"
+func (c *Client) CompleteTask(ctx context.Context, id int64, cascade bool) (models.Task, error) {
+	const op = "client.CompleteTask"
+
+	resp, err := c.client.CompleteTask(ctx, &dbpb.CompleteTaskRequest{
+		Id:      id,
+		Cascade: cascade,
+	})
+
+	if err != nil {
+		return models.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toModel(resp.Task), nil
+}
+
+func (c *Client) TransitionTask(ctx context.Context, id int64, status string) error {
+	const op = "client.TransitionTask"
+
+	_, err := c.client.TransitionTask(ctx, &dbpb.TransitionTaskRequest{
+		Id:     id,
+		Status: toStatus(status),
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 
-	_, err := c.client.DeleteTask(context.Background(), &dbpb.TaskId{
+	return nil
+}
+
+func (c *Client) ReopenTask(ctx context.Context, id int64) error {
+	const op = "client.ReopenTask"
+
+	_, err := c.client.ReopenTask(ctx, &dbpb.TaskId{
 		Id: id,
 	})
 
 	if err != nil {
-		return fmt.Errorf("%s: %v", op, err)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	return nil
 }
 
-func (c *Client) CompleteTask(id int64) error {
-	const op = "client.CompleteTask"
+func (c *Client) ArchiveTask(ctx context.Context, id int64) error {
+	const op = "client.ArchiveTask"
 
-	_, err := c.client.CompleteTask(context.Background(), &dbpb.TaskId{
+	_, err := c.client.ArchiveTask(ctx, &dbpb.TaskId{
 		Id: id,
 	})
 
 	if err != nil {
-		return fmt.Errorf("%s: %v", op, err)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	return nil
 }
 
-func (c *Client) ListTasks() ([]models.Task, error) {
-	const op = "client.ListTasks"
+func (c *Client) UnarchiveTask(ctx context.Context, id int64) error {
+	const op = "client.UnarchiveTask"
 
-	tasks, err := c.client.ListTasks(context.Background(), &dbpb.Empty{})
+	_, err := c.client.UnarchiveTask(ctx, &dbpb.TaskId{
+		Id: id,
+	})
 
 	if err != nil {
-		return nil, fmt.Errorf("%s: %v", op, err)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	var resp []models.Task
+	return nil
+}
+
+func (c *Client) ArchiveCompletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	const op = "client.ArchiveCompletedBefore"
 
-	for _, v := range tasks.Tasks {
-		var completedAt *time.Time
+	resp, err := c.client.ArchiveCompletedBefore(ctx, &dbpb.ArchiveBeforeRequest{
+		Before: timestamppb.New(before),
+	})
 
-		if v.CompletedAt != nil {
-			t := v.CompletedAt.AsTime()
-			completedAt = &t
-		}
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return resp.Archived, nil
+}
+
+func (c *Client) ListArchivedTasks(ctx context.Context) ([]models.Task, error) {
+	const op = "client.ListArchivedTasks"
 
-		resp = append(resp, models.Task{
-			Id:          v.Id,
-			Name:        v.Title,
-			Description: v.Description,
-			Completed:   v.Completed,
-			CreatedAt:   v.CreatedAt.AsTime(),
-			CompletedAt: completedAt,
+	tasks, err := c.client.ListArchivedTasks(ctx, &dbpb.Empty{})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toModels(tasks.Tasks), nil
+}
+
+func (c *Client) SearchTasks(ctx context.Context, query, language string, limit int32) ([]models.SearchHit, error) {
+	const op = "client.SearchTasks"
+
+	resp, err := c.client.SearchTasks(ctx, &dbpb.SearchTasksRequest{
+		Query:    query,
+		Language: language,
+		Limit:    limit,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var hits []models.SearchHit
+
+	for _, v := range resp.Hits {
+		hits = append(hits, models.SearchHit{
+			Task:    toModel(v.Task),
+			Rank:    v.Rank,
+			Snippet: v.Snippet,
 		})
 	}
 
-	return resp, nil
+	return hits, nil
 }
 
-func (c *Client) ListCompletedTasks() ([]models.Task, error) {
-	const op = "client.ListCompletedTasks"
+func (c *Client) ListTrash(ctx context.Context) ([]models.Task, error) {
+	const op = "client.ListTrash"
 
-	tasks, err := c.client.ListCompletedTasks(context.Background(), &dbpb.Empty{})
+	tasks, err := c.client.ListTrash(ctx, &dbpb.Empty{})
 
 	if err != nil {
-		return nil, fmt.Errorf("%s: %v", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	var resp []models.Task
+	return toModels(tasks.Tasks), nil
+}
 
-	for _, v := range tasks.Tasks {
-		var completedAt *time.Time
+func (c *Client) RestoreTask(ctx context.Context, id int64) error {
+	const op = "client.RestoreTask"
 
-		if v.CompletedAt != nil {
-			t := v.CompletedAt.AsTime()
-			completedAt = &t
+	_, err := c.client.RestoreTask(ctx, &dbpb.TaskId{
+		Id: id,
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) PurgeTask(ctx context.Context, id int64) error {
+	const op = "client.PurgeTask"
+
+	_, err := c.client.PurgeTask(ctx, &dbpb.TaskId{
+		Id: id,
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListTasks(ctx context.Context, query models.TaskQuery) (models.TaskPage, error) {
+	const op = "client.ListTasks"
+
+	req := &dbpb.ListTasksRequest{
+		PageSize:  query.Limit,
+		PageToken: query.Cursor,
+		Filter: &dbpb.TaskFilter{
+			Q:          query.Q,
+			Completed:  query.Completed,
+			Expression: query.Filter,
+		},
+	}
+
+	if !query.CreatedAfter.IsZero() {
+		req.Filter.CreatedAfter = timestamppb.New(query.CreatedAfter)
+	}
+
+	if !query.CreatedBefore.IsZero() {
+		req.Filter.CreatedBefore = timestamppb.New(query.CreatedBefore)
+	}
+
+	for _, key := range query.Sort {
+		req.Sort = append(req.Sort, &dbpb.SortField{Field: key.Field, Desc: key.Desc})
+	}
+
+	tasks, err := c.client.ListTasks(ctx, req)
+
+	if err != nil {
+		if ferr := toFilterError(err); ferr != nil {
+			return models.TaskPage{}, fmt.Errorf("%s: %w: %w", op, ferr, err)
 		}
 
-		resp = append(resp, models.Task{
-			Id:          v.Id,
-			Name:        v.Title,
-			Description: v.Description,
-			Completed:   v.Completed,
-			CreatedAt:   v.CreatedAt.AsTime(),
-			CompletedAt: completedAt,
-		})
+		return models.TaskPage{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return resp, nil
+	return toPage(tasks), nil
+}
+
+func (c *Client) ListCompletedTasks(ctx context.Context, limit int32, cursor string) (models.TaskPage, error) {
+	const op = "client.ListCompletedTasks"
+
+	tasks, err := c.client.ListCompletedTasks(ctx, &dbpb.PageRequest{
+		PageSize:  limit,
+		PageToken: cursor,
+	})
+
+	if err != nil {
+		return models.TaskPage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toPage(tasks), nil
 }
-func (c *Client) ListNotCompletedTasks() ([]models.Task, error) {
+
+func (c *Client) ListNotCompletedTasks(ctx context.Context, limit int32, cursor string) (models.TaskPage, error) {
 	const op = "client.ListNotCompletedTasks"
 
-	tasks, err := c.client.ListNotCompletedTasks(context.Background(), &dbpb.Empty{})
+	tasks, err := c.client.ListNotCompletedTasks(ctx, &dbpb.PageRequest{
+		PageSize:  limit,
+		PageToken: cursor,
+	})
 
 	if err != nil {
-		return nil, fmt.Errorf("%s: %v", op, err)
+		return models.TaskPage{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	var resp []models.Task
+	return toPage(tasks), nil
+}
+
+func (c *Client) ListTasksByPriority(ctx context.Context) ([]models.Task, error) {
+	const op = "client.ListTasksByPriority"
 
-	for _, v := range tasks.Tasks {
-		var completedAt *time.Time
+	tasks, err := c.client.ListTasksByPriority(ctx, &dbpb.Empty{})
 
-		if v.CompletedAt != nil {
-			t := v.CompletedAt.AsTime()
-			completedAt = &t
-		}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toModels(tasks.Tasks), nil
+}
+
+func (c *Client) ListOverdueTasks(ctx context.Context) ([]models.Task, error) {
+	const op = "client.ListOverdueTasks"
+
+	tasks, err := c.client.ListOverdueTasks(ctx, &dbpb.Empty{})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toModels(tasks.Tasks), nil
+}
+
+func (c *Client) ListDueBetween(ctx context.Context, from, to time.Time) ([]models.Task, error) {
+	const op = "client.ListDueBetween"
+
+	tasks, err := c.client.ListDueBetween(ctx, &dbpb.DueRangeRequest{
+		From: timestamppb.New(from),
+		To:   timestamppb.New(to),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toModels(tasks.Tasks), nil
+}
+
+func (c *Client) ListTasksByTags(ctx context.Context, tags []string, matchAll bool) ([]models.Task, error) {
+	const op = "client.ListTasksByTags"
+
+	tasks, err := c.client.ListTasksByTags(ctx, &dbpb.TagFilterRequest{
+		Tags:     tags,
+		MatchAll: matchAll,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toModels(tasks.Tasks), nil
+}
 
-		resp = append(resp, models.Task{
-			Id:          v.Id,
-			Name:        v.Title,
-			Description: v.Description,
-			Completed:   v.Completed,
-			CreatedAt:   v.CreatedAt.AsTime(),
-			CompletedAt: completedAt,
+func (c *Client) AttachTag(ctx context.Context, id int64, tag string) error {
+	const op = "client.AttachTag"
+
+	_, err := c.client.AttachTag(ctx, &dbpb.TagRequest{
+		TaskId: id,
+		Tag:    tag,
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) DetachTag(ctx context.Context, id int64, tag string) error {
+	const op = "client.DetachTag"
+
+	_, err := c.client.DetachTag(ctx, &dbpb.TagRequest{
+		TaskId: id,
+		Tag:    tag,
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListTags(ctx context.Context) ([]models.Tag, error) {
+	const op = "client.ListTags"
+
+	tags, err := c.client.ListTags(ctx, &dbpb.Empty{})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var resp []models.Tag
+
+	for _, v := range tags.Tags {
+		resp = append(resp, models.Tag{
+			Name:  v.Name,
+			Count: v.Count,
 		})
 	}
 
 	return resp, nil
 }
+
+func (c *Client) ListSubtasks(ctx context.Context, id int64, recursive bool) ([]models.Task, error) {
+	const op = "client.ListSubtasks"
+
+	tasks, err := c.client.ListSubtasks(ctx, &dbpb.SubtasksRequest{
+		Id:        id,
+		Recursive: recursive,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toModels(tasks.Tasks), nil
+}
+
+func (c *Client) MoveTask(ctx context.Context, id int64, parentID *int64) error {
+	const op = "client.MoveTask"
+
+	_, err := c.client.MoveTask(ctx, &dbpb.MoveTaskRequest{
+		Id:       id,
+		ParentId: fromID(parentID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) AddDependency(ctx context.Context, id, blockedByID int64) error {
+	const op = "client.AddDependency"
+
+	_, err := c.client.AddDependency(ctx, &dbpb.DependencyRequest{
+		TaskId:      id,
+		BlockedById: blockedByID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveDependency(ctx context.Context, id, blockedByID int64) error {
+	const op = "client.RemoveDependency"
+
+	_, err := c.client.RemoveDependency(ctx, &dbpb.DependencyRequest{
+		TaskId:      id,
+		BlockedById: blockedByID,
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListBlockers(ctx context.Context, id int64) ([]models.Task, error) {
+	const op = "client.ListBlockers"
+
+	tasks, err := c.client.ListBlockers(ctx, &dbpb.TaskId{
+		Id: id,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toModels(tasks.Tasks), nil
+}
+
+func (c *Client) ListReadyTasks(ctx context.Context) ([]models.Task, error) {
+	const op = "client.ListReadyTasks"
+
+	tasks, err := c.client.ListReadyTasks(ctx, &dbpb.Empty{})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toModels(tasks.Tasks), nil
+}
+
+func toModel(v *dbpb.TaskItem) models.Task {
+	completedAt := fromTimestamp(v.CompletedAt)
+	dueAt := fromTimestamp(v.DueAt)
+	taskStatus := fromStatus(v.Status)
+
+	return models.Task{
+		Id:              v.Id,
+		Name:            v.Title,
+		Description:     v.Description,
+		Completed:       v.Completed,
+		Status:          taskStatus,
+		StatusChangedAt: fromTimestamp(v.StatusChangedAt),
+		Priority:        fromPriority(v.Priority),
+		CreatedAt:       v.CreatedAt.AsTime(),
+		CompletedAt:     completedAt,
+		DueAt:           dueAt,
+		Overdue:         !models.ClosedStatus(taskStatus) && dueAt != nil && dueAt.Before(time.Now()),
+		Tags:            v.Tags,
+		ParentId:        toID(v.ParentId),
+		Recurrence:      v.Recurrence,
+		NextDueAt:       fromTimestamp(v.NextOccurrenceAt),
+		ProjectId:       toID(v.ProjectId),
+		DeletedAt:       fromTimestamp(v.DeletedAt),
+		ArchivedAt:      fromTimestamp(v.ArchivedAt),
+		Version:         v.Version,
+	}
+}
+
+func toModels(items []*dbpb.TaskItem) []models.Task {
+	resp := make([]models.Task, 0, len(items))
+
+	for _, v := range items {
+		resp = append(resp, toModel(v))
+	}
+
+	return resp
+}
+
+func toPage(resp *dbpb.TasksResponse) models.TaskPage {
+	return models.TaskPage{
+		Items:      toModels(resp.Tasks),
+		NextCursor: resp.NextPageToken,
+	}
+}
+
+// taskError marks the failure of a conditional task write on a stale version with
+// ErrVersionMismatch. The gRPC status stays in the chain.
+func taskError(err error) error {
+	if status.Code(err) == codes.Aborted {
+		return fmt.Errorf("%w: %w", models.ErrVersionMismatch, err)
+	}
+
+	return err
+}
+
+// toFilterError extracts the filter span that db-service attaches to an InvalidArgument
+// status. It returns nil for any other error.
+func toFilterError(err error) *models.FilterError {
+	if status.Code(err) != codes.InvalidArgument {
+		return nil
+	}
+
+	info := errorInfo(err, "INVALID_FILTER")
+
+	if info == nil {
+		return nil
+	}
+
+	pos, _ := strconv.Atoi(info.GetMetadata()["pos"])
+	end, _ := strconv.Atoi(info.GetMetadata()["end"])
+
+	return &models.FilterError{Pos: pos, End: end, Message: info.GetMetadata()["message"]}
+}
+
+// errorInfo returns the ErrorInfo detail with the given reason that db-service attached to the
+// status carried by err, or nil when there is none.
+func errorInfo(err error, reason string) *errdetails.ErrorInfo {
+	st, ok := status.FromError(err)
+
+	if !ok {
+		return nil
+	}
+
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetReason() == reason {
+			return info
+		}
+	}
+
+	return nil
+}
+
+// toPriority converts a priority name to the enum; an empty name is PRIORITY_NONE. An unknown
+// name becomes a value outside the enum, so db-service reports it with the other violations.
+func toPriority(p string) dbpb.Priority {
+	if p == "" {
+		return dbpb.Priority_PRIORITY_NONE
+	}
+
+	v, ok := dbpb.Priority_value[priorityPrefix+strings.ToUpper(p)]
+
+	if !ok || p != strings.ToLower(p) {
+		return -1
+	}
+
+	return dbpb.Priority(v)
+}
+
+// toOptionalPriority is toPriority for requests where an empty priority means "keep the stored one".
+func toOptionalPriority(p string) *dbpb.Priority {
+	if p == "" {
+		return nil
+	}
+
+	priority := toPriority(p)
+
+	return &priority
+}
+
+func fromPriority(p dbpb.Priority) string {
+	return strings.ToLower(strings.TrimPrefix(p.String(), priorityPrefix))
+}
+
+func toStatus(s string) dbpb.TaskStatus {
+	return dbpb.TaskStatus(dbpb.TaskStatus_value[statusPrefix+strings.ToUpper(s)])
+}
+
+func fromStatus(s dbpb.TaskStatus) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), statusPrefix))
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+
+	return &t
+}
+
+func toID(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+
+	return &id
+}
+
+func fromID(id *int64) int64 {
+	if id == nil {
+		return 0
+	}
+
+	return *id
+}
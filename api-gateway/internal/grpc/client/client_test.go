@@ -0,0 +1,74 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+	"time"
+	dbpb "todo/proto/db/gen"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestToPriority(t *testing.T) {
+	tests := []struct {
+		name string
+		want dbpb.Priority
+	}{
+		{"", dbpb.Priority_PRIORITY_NONE},
+		{"none", dbpb.Priority_PRIORITY_NONE},
+		{"urgent", dbpb.Priority_PRIORITY_URGENT},
+		{"HIGH", -1},
+		{"asap", -1},
+	}
+
+	for _, tt := range tests {
+		if got := toPriority(tt.name); got != tt.want {
+			t.Errorf("toPriority(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestToModelOverdue(t *testing.T) {
+	past := timestamppb.New(time.Now().Add(-time.Hour))
+	future := timestamppb.New(time.Now().Add(time.Hour))
+
+	tests := []struct {
+		status dbpb.TaskStatus
+		dueAt  *timestamppb.Timestamp
+		want   bool
+	}{
+		{dbpb.TaskStatus_TASK_STATUS_TODO, past, true},
+		{dbpb.TaskStatus_TASK_STATUS_REVIEW, past, true},
+		{dbpb.TaskStatus_TASK_STATUS_TODO, future, false},
+		{dbpb.TaskStatus_TASK_STATUS_TODO, nil, false},
+		{dbpb.TaskStatus_TASK_STATUS_DONE, past, false},
+		{dbpb.TaskStatus_TASK_STATUS_CANCELLED, past, false},
+	}
+
+	for _, tt := range tests {
+		task := toModel(&dbpb.TaskItem{Status: tt.status, DueAt: tt.dueAt, CreatedAt: timestamppb.Now()})
+
+		if task.Overdue != tt.want {
+			t.Errorf("toModel(%v, due %v): overdue = %v, want %v", tt.status, tt.dueAt.AsTime(), task.Overdue, tt.want)
+		}
+	}
+}
+
+func TestErrorInfo(t *testing.T) {
+	st, _ := status.New(codes.AlreadyExists, "key reused").WithDetails(&errdetails.ErrorInfo{
+		Reason: "IDEMPOTENCY_KEY_REUSED",
+		Domain: "todo.db",
+	})
+	err := fmt.Errorf("client.CreateTask: %w", st.Err())
+
+	if errorInfo(err, "IDEMPOTENCY_KEY_REUSED") == nil {
+		t.Error("errorInfo: the reason attached by db-service must be found")
+	}
+
+	if errorInfo(status.Error(codes.AlreadyExists, "conflict"), "IDEMPOTENCY_KEY_REUSED") != nil {
+		t.Error("errorInfo: a bare AlreadyExists must not match")
+	}
+}
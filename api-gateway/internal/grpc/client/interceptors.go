@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"expvar"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+	"todo/api/internal/config"
+	dbpb "todo/proto/db/gen"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Call and attempt counts per method, served on /debug/vars by the metrics listener.
+// attempts/calls is the average number of attempts a call needs.
+var (
+	callCount    = expvar.NewMap("grpc_client_calls")
+	attemptCount = expvar.NewMap("grpc_client_attempts")
+)
+
+// timeoutInterceptor bounds every call by timeout. A context that already ends sooner keeps
+// its own deadline, and a zero timeout leaves calls unbounded.
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if timeout <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// retryInterceptor retries calls that failed with Unavailable or DeadlineExceeded, up to
+// cfg.Attempts attempts in total, waiting a jittered exponential backoff in between. Only
+// reads and writes carrying an idempotency key are retried: repeating any other write could
+// apply it twice.
+func retryInterceptor(cfg config.Retry, log *slog.Logger) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		attempts := 1
+		maxAttempts := cfg.Attempts
+
+		if !retryable(method, req) {
+			maxAttempts = 1
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		for ; err != nil && attempts < maxAttempts && retryableCode(status.Code(err)); attempts++ {
+			delay := backoff(cfg, attempts)
+
+			log.Warn("retrying db-service call",
+				slog.String("method", method),
+				slog.Int("attempt", attempts),
+				slog.String("code", status.Code(err).String()),
+				slog.Duration("backoff", delay),
+			)
+
+			if !sleep(ctx, delay) {
+				break
+			}
+
+			err = invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		callCount.Add(method, 1)
+		attemptCount.Add(method, int64(attempts))
+
+		if attempts > 1 {
+			log.Info("db-service call retried",
+				slog.String("method", method),
+				slog.Int("attempts", attempts),
+				slog.Bool("ok", err == nil),
+			)
+		}
+
+		return err
+	}
+}
+
+// readMethods are the db-service methods that only read. A new method is retried only once
+// it is added here.
+var readMethods = map[string]bool{
+	dbpb.TaskService_GetTask_FullMethodName:               true,
+	dbpb.TaskService_ListTasks_FullMethodName:             true,
+	dbpb.TaskService_ListCompletedTasks_FullMethodName:    true,
+	dbpb.TaskService_ListNotCompletedTasks_FullMethodName: true,
+	dbpb.TaskService_ListTasksByPriority_FullMethodName:   true,
+	dbpb.TaskService_ListOverdueTasks_FullMethodName:      true,
+	dbpb.TaskService_ListDueBetween_FullMethodName:        true,
+	dbpb.TaskService_ListTags_FullMethodName:              true,
+	dbpb.TaskService_ListTasksByTags_FullMethodName:       true,
+	dbpb.TaskService_ListSubtasks_FullMethodName:          true,
+	dbpb.TaskService_ListBlockers_FullMethodName:          true,
+	dbpb.TaskService_ListReadyTasks_FullMethodName:        true,
+	dbpb.TaskService_ListTrash_FullMethodName:             true,
+	dbpb.TaskService_ListArchivedTasks_FullMethodName:     true,
+	dbpb.TaskService_SearchTasks_FullMethodName:           true,
+	dbpb.ProjectService_GetProject_FullMethodName:         true,
+	dbpb.ProjectService_ListProjects_FullMethodName:       true,
+	dbpb.ProjectService_ListProjectTasks_FullMethodName:   true,
+	dbpb.ViewService_GetView_FullMethodName:               true,
+	dbpb.ViewService_ListViews_FullMethodName:             true,
+	dbpb.ViewService_ListViewTasks_FullMethodName:         true,
+}
+
+// retryable reports whether a call can safely be repeated: read methods change nothing, and a
+// write with an idempotency key is deduplicated by db-service.
+func retryable(method string, req any) bool {
+	if readMethods[method] {
+		return true
+	}
+
+	keyed, ok := req.(interface{ GetIdempotencyKey() string })
+
+	return ok && keyed.GetIdempotencyKey() != ""
+}
+
+func retryableCode(code codes.Code) bool {
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
+
+// backoff returns the wait after the given failed attempt: cfg.Backoff doubled for every
+// earlier attempt and capped at cfg.MaxBackoff, then jittered down by up to half so that
+// clients failing together don't retry together.
+func backoff(cfg config.Retry, attempt int) time.Duration {
+	delay := cfg.Backoff
+
+	for i := 1; i < attempt && (cfg.MaxBackoff <= 0 || delay < cfg.MaxBackoff); i++ {
+		delay *= 2
+	}
+
+	if cfg.MaxBackoff > 0 && delay > cfg.MaxBackoff {
+		delay = cfg.MaxBackoff
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// sleep waits for d and reports false if ctx ends first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
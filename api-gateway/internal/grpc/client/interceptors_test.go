@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	"todo/api/internal/config"
+	dbpb "todo/proto/db/gen"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryInterceptor(t *testing.T) {
+	retry := retryInterceptor(
+		config.Retry{Attempts: 3, Backoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond},
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+
+	call := func(method string, req any, failures ...codes.Code) (int, error) {
+		calls := 0
+		invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+			calls++
+
+			if calls <= len(failures) {
+				return status.Error(failures[calls-1], "failed")
+			}
+
+			return nil
+		}
+
+		err := retry(context.Background(), method, req, nil, nil, invoker)
+
+		return calls, err
+	}
+
+	if calls, err := call("/db.TaskService/GetTask", &dbpb.TaskId{}, codes.Unavailable, codes.DeadlineExceeded); calls != 3 || err != nil {
+		t.Fatalf("GetTask: calls = %d, err = %v, want 3 calls and success", calls, err)
+	}
+
+	if calls, err := call("/db.TaskService/ListTasks", &dbpb.ListTasksRequest{}, codes.Unavailable, codes.Unavailable, codes.Unavailable); calls != 3 || status.Code(err) != codes.Unavailable {
+		t.Fatalf("ListTasks: calls = %d, err = %v, want 3 calls and Unavailable", calls, err)
+	}
+
+	if calls, err := call("/db.TaskService/SearchTasks", &dbpb.SearchTasksRequest{}, codes.Unavailable); calls != 2 || err != nil {
+		t.Fatalf("SearchTasks: calls = %d, err = %v, want a read to be retried", calls, err)
+	}
+
+	if calls, _ := call("/db.TaskService/ListSomethingNew", &dbpb.Empty{}, codes.Unavailable); calls != 1 {
+		t.Fatalf("ListSomethingNew: calls = %d, want a method outside the allowlist not to be retried", calls)
+	}
+
+	if calls, _ := call("/db.TaskService/GetTask", &dbpb.TaskId{}, codes.NotFound); calls != 1 {
+		t.Fatalf("GetTask: calls = %d, want NotFound not to be retried", calls)
+	}
+
+	if calls, _ := call("/db.TaskService/CreateTask", &dbpb.TaskRequest{}, codes.Unavailable); calls != 1 {
+		t.Fatalf("CreateTask: calls = %d, want a write without a key not to be retried", calls)
+	}
+
+	if calls, err := call("/db.TaskService/CreateTask", &dbpb.TaskRequest{IdempotencyKey: "k"}, codes.Unavailable); calls != 2 || err != nil {
+		t.Fatalf("CreateTask: calls = %d, err = %v, want a keyed write to be retried", calls, err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cfg := config.Retry{Backoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	for attempt, limit := range map[int]time.Duration{1: 100, 2: 200, 3: 300, 10: 300} {
+		limit *= time.Millisecond
+
+		for range 20 {
+			if d := backoff(cfg, attempt); d < limit/2 || d > limit {
+				t.Fatalf("backoff(%d) = %v, want within [%v, %v]", attempt, d, limit/2, limit)
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"todo/api/internal/domain/models"
+	dbpb "todo/proto/db/gen"
+)
+
+func (c *Client) CreateProject(ctx context.Context, project models.Project) (models.Project, error) {
+	const op = "client.CreateProject"
+
+	resp, err := c.projects.CreateProject(ctx, &dbpb.ProjectRequest{
+		Name:        project.Name,
+		Description: project.Description,
+	})
+
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toProjectModel(resp.Project), nil
+}
+
+func (c *Client) GetProject(ctx context.Context, id int64) (models.Project, error) {
+	const op = "client.GetProject"
+
+	resp, err := c.projects.GetProject(ctx, &dbpb.ProjectId{
+		Id: id,
+	})
+
+	if err != nil {
+		return models.Project{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toProjectModel(resp.Project), nil
+}
+
+func (c *Client) EditProject(ctx context.Context, project models.Project) error {
+	const op = "client.EditProject"
+
+	_, err := c.projects.EditProject(ctx, &dbpb.EditProjectRequest{
+		Id:          project.Id,
+		Name:        project.Name,
+		Description: project.Description,
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteProject(ctx context.Context, id int64, cascade bool) error {
+	const op = "client.DeleteProject"
+
+	mode := dbpb.DeleteMode_DELETE_MODE_ARCHIVE
+
+	if cascade {
+		mode = dbpb.DeleteMode_DELETE_MODE_CASCADE
+	}
+
+	_, err := c.projects.DeleteProject(ctx, &dbpb.DeleteProjectRequest{
+		Id:   id,
+		Mode: mode,
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListProjects(ctx context.Context, includeArchived bool) ([]models.Project, error) {
+	const op = "client.ListProjects"
+
+	resp, err := c.projects.ListProjects(ctx, &dbpb.ListProjectsRequest{
+		IncludeArchived: includeArchived,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	projects := make([]models.Project, 0, len(resp.Projects))
+
+	for _, v := range resp.Projects {
+		projects = append(projects, toProjectModel(v))
+	}
+
+	return projects, nil
+}
+
+func (c *Client) ListProjectTasks(ctx context.Context, id int64) ([]models.Task, error) {
+	const op = "client.ListProjectTasks"
+
+	tasks, err := c.projects.ListProjectTasks(ctx, &dbpb.ProjectId{
+		Id: id,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toModels(tasks.Tasks), nil
+}
+
+func toProjectModel(v *dbpb.ProjectItem) models.Project {
+	return models.Project{
+		Id:          v.Id,
+		Name:        v.Name,
+		Description: v.Description,
+		CreatedAt:   v.CreatedAt.AsTime(),
+		ArchivedAt:  fromTimestamp(v.ArchivedAt),
+	}
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"todo/api/internal/domain/models"
+	dbpb "todo/proto/db/gen"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *Client) CreateView(ctx context.Context, view models.View) (models.View, error) {
+	const op = "client.CreateView"
+
+	resp, err := c.views.CreateView(ctx, &dbpb.ViewRequest{
+		Owner:  view.Owner,
+		Name:   view.Name,
+		Filter: toFilterMessage(view.Criteria),
+		Sort:   toSortFields(view.Sort),
+	})
+
+	if err != nil {
+		return models.View{}, fmt.Errorf("%s: %w", op, viewError(err))
+	}
+
+	return toViewModel(resp.View), nil
+}
+
+func (c *Client) GetView(ctx context.Context, id int64, owner string) (models.View, error) {
+	const op = "client.GetView"
+
+	resp, err := c.views.GetView(ctx, &dbpb.ViewId{
+		Id:    id,
+		Owner: owner,
+	})
+
+	if err != nil {
+		return models.View{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toViewModel(resp.View), nil
+}
+
+func (c *Client) EditView(ctx context.Context, view models.View) error {
+	const op = "client.EditView"
+
+	_, err := c.views.EditView(ctx, &dbpb.EditViewRequest{
+		Id:     view.Id,
+		Owner:  view.Owner,
+		Name:   view.Name,
+		Filter: toFilterMessage(view.Criteria),
+		Sort:   toSortFields(view.Sort),
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, viewError(err))
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteView(ctx context.Context, id int64, owner string) error {
+	const op = "client.DeleteView"
+
+	_, err := c.views.DeleteView(ctx, &dbpb.ViewId{
+		Id:    id,
+		Owner: owner,
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListViews(ctx context.Context, owner string) ([]models.View, error) {
+	const op = "client.ListViews"
+
+	resp, err := c.views.ListViews(ctx, &dbpb.ListViewsRequest{
+		Owner: owner,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var views []models.View
+
+	for _, v := range resp.Views {
+		views = append(views, toViewModel(v))
+	}
+
+	return views, nil
+}
+
+func (c *Client) ListViewTasks(ctx context.Context, id int64, owner string, limit int32, cursor string) (models.TaskPage, error) {
+	const op = "client.ListViewTasks"
+
+	tasks, err := c.views.ListViewTasks(ctx, &dbpb.ListViewTasksRequest{
+		Id:        id,
+		Owner:     owner,
+		PageSize:  limit,
+		PageToken: cursor,
+	})
+
+	if err != nil {
+		return models.TaskPage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return toPage(tasks), nil
+}
+
+// viewError marks the errors a view handler answers with a client error: a rejected filter
+// expression and a duplicate name. The gRPC status stays in the chain.
+func viewError(err error) error {
+	if ferr := toFilterError(err); ferr != nil {
+		return fmt.Errorf("%w: %w", ferr, err)
+	}
+
+	if status.Code(err) == codes.AlreadyExists {
+		return fmt.Errorf("%w: %w", models.ErrViewExists, err)
+	}
+
+	return err
+}
+
+func toFilterMessage(c models.ViewCriteria) *dbpb.TaskFilter {
+	return &dbpb.TaskFilter{
+		Q:             c.Q,
+		Completed:     c.Completed,
+		CreatedAfter:  toTimestamp(c.CreatedAfter),
+		CreatedBefore: toTimestamp(c.CreatedBefore),
+		Expression:    c.Filter,
+	}
+}
+
+func toSortFields(spec string) []*dbpb.SortField {
+	var fields []*dbpb.SortField
+
+	for _, field := range strings.Split(spec, ",") {
+		if field == "" {
+			continue
+		}
+
+		desc := strings.HasPrefix(field, "-")
+		fields = append(fields, &dbpb.SortField{Field: strings.TrimPrefix(field, "-"), Desc: desc})
+	}
+
+	return fields
+}
+
+func toViewModel(v *dbpb.ViewItem) models.View {
+	view := models.View{
+		Id:        v.Id,
+		Owner:     v.Owner,
+		Name:      v.Name,
+		CreatedAt: v.CreatedAt.AsTime(),
+		Criteria: models.ViewCriteria{
+			Q:         v.GetFilter().GetQ(),
+			Completed: v.GetFilter().Completed,
+			Filter:    v.GetFilter().GetExpression(),
+		},
+	}
+
+	if ts := v.GetFilter().GetCreatedAfter(); ts != nil {
+		t := ts.AsTime()
+		view.Criteria.CreatedAfter = &t
+	}
+
+	if ts := v.GetFilter().GetCreatedBefore(); ts != nil {
+		t := ts.AsTime()
+		view.Criteria.CreatedBefore = &t
+	}
+
+	fields := make([]string, 0, len(v.Sort))
+
+	for _, f := range v.Sort {
+		if f.Desc {
+			fields = append(fields, "-"+f.Field)
+		} else {
+			fields = append(fields, f.Field)
+		}
+	}
+
+	view.Sort = strings.Join(fields, ",")
+
+	return view
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"todo/api/internal/domain/models"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *Handlers) ListArchivedTasksHandler(w http.ResponseWriter, r *http.Request) {
+	tasks, err := h.todo.ListArchivedTasks(r.Context())
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if tasks == nil {
+		tasks = []models.Task{}
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=list_archived_tasks count=%d",
+			time.Now().Format(time.RFC3339), len(tasks)),
+	)
+
+	writeTasks(w, tasks)
+}
+
+func (h *Handlers) ArchiveTaskHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.todo.ArchiveTask(r.Context(), id); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=archive_task id=%d",
+			time.Now().Format(time.RFC3339), id),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) UnarchiveTaskHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.todo.UnarchiveTask(r.Context(), id); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=unarchive_task id=%d",
+			time.Now().Format(time.RFC3339), id),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) ArchiveCompletedHandler(w http.ResponseWriter, r *http.Request) {
+	before, err := time.Parse(time.RFC3339, r.URL.Query().Get("before"))
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid before")
+		return
+	}
+
+	archived, err := h.todo.ArchiveCompletedBefore(r.Context(), before)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=archive_completed before=%s count=%d",
+			time.Now().Format(time.RFC3339), before.Format(time.RFC3339), archived),
+	)
+
+	_ = json.NewEncoder(w).Encode(map[string]int64{"archived": archived})
+}
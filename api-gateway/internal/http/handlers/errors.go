@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"todo/api/internal/domain/models"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcStatuses maps the gRPC codes db-service answers with to HTTP statuses.
+var grpcStatuses = map[codes.Code]int{
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.FailedPrecondition: http.StatusConflict,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+}
+
+// writeError answers a failed call to db-service with a problem. Errors the client marked with
+// a domain error get a problem type of their own, and field violations reported by db-service
+// become a validation problem; everything else is mapped from its gRPC code, and anything
+// without one is a 500. Server errors carry no detail, so internal messages never reach the
+// client.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var ferr *models.FilterError
+
+	violations := statusViolations(err)
+
+	switch {
+	case errors.As(err, &ferr):
+		writeInvalidFilter(w, r, ferr)
+	case errors.Is(err, models.ErrVersionMismatch):
+		sendProblem(w, r, problem{
+			Type:   problemVersionMismatch,
+			Title:  "Version mismatch",
+			Status: http.StatusPreconditionFailed,
+			Detail: "the task was modified by someone else; fetch it again and retry with its new ETag",
+		})
+	case errors.Is(err, models.ErrIdempotencyMismatch):
+		sendProblem(w, r, problem{
+			Type:   problemIdempotencyReused,
+			Title:  "Idempotency key reused",
+			Status: http.StatusUnprocessableEntity,
+			Detail: models.ErrIdempotencyMismatch.Error(),
+		})
+	case errors.Is(err, models.ErrViewExists):
+		sendProblem(w, r, problem{
+			Type:   problemViewExists,
+			Title:  "View already exists",
+			Status: http.StatusConflict,
+			Detail: models.ErrViewExists.Error(),
+		})
+	case len(violations) > 0:
+		writeValidationProblem(w, r, "invalid request", violations)
+	default:
+		code := httpStatus(err)
+
+		var detail string
+
+		if code < http.StatusInternalServerError {
+			detail = statusDetail(err)
+		}
+
+		writeProblem(w, r, code, detail)
+	}
+}
+
+// httpStatus returns the HTTP status for the gRPC code carried by err.
+func httpStatus(err error) int {
+	if code, ok := grpcStatuses[status.Code(err)]; ok {
+		return code
+	}
+
+	return http.StatusInternalServerError
+}
+
+// statusDetail returns the message db-service put in the status carried by err, without the
+// chain of operation prefixes in front of it.
+func statusDetail(err error) string {
+	var se interface{ GRPCStatus() *status.Status }
+
+	if !errors.As(err, &se) {
+		return ""
+	}
+
+	msg := se.GRPCStatus().Message()
+
+	if i := strings.LastIndex(msg, ": "); i >= 0 {
+		msg = msg[i+2:]
+	}
+
+	return msg
+}
+
+// grpcFields maps the db-service field names that differ from the JSON ones.
+var grpcFields = map[string]string{
+	"title": "name",
+}
+
+// statusViolations returns the field violations db-service attached to an InvalidArgument
+// status, named as the REST API names them.
+func statusViolations(err error) []fieldError {
+	var se interface{ GRPCStatus() *status.Status }
+
+	if !errors.As(err, &se) || se.GRPCStatus().Code() != codes.InvalidArgument {
+		return nil
+	}
+
+	var errs []fieldError
+
+	for _, detail := range se.GRPCStatus().Details() {
+		br, ok := detail.(*errdetails.BadRequest)
+
+		if !ok {
+			continue
+		}
+
+		for _, fv := range br.GetFieldViolations() {
+			field := fv.GetField()
+
+			if name, ok := grpcFields[field]; ok {
+				field = name
+			}
+
+			errs = append(errs, fieldError{Field: field, Message: fv.GetDescription()})
+		}
+	}
+
+	return errs
+}
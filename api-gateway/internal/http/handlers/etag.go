@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"todo/api/internal/domain/models"
+)
+
+// etag formats a task version as a strong entity tag.
+func etag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// parseETags reads the task versions listed in an If-Match or If-None-Match header. wildcard is
+// true for "*". Weak tags are only accepted when weak is set, since If-Match compares strongly.
+// Tags that don't name a version are skipped: they can never match.
+func parseETags(header string, weak bool) (versions []int64, wildcard bool) {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+
+		if tag == "*" {
+			return nil, true
+		}
+
+		if strings.HasPrefix(tag, "W/") {
+			if !weak {
+				continue
+			}
+
+			tag = tag[2:]
+		}
+
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+
+		if v, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64); err == nil {
+			versions = append(versions, v)
+		}
+	}
+
+	return versions, false
+}
+
+// ifMatch resolves the If-Match header of a task write to the version the write is conditioned
+// on. Zero means the write is unconditional. With several tags the current version is looked up
+// and used if it is listed. ok is false when the precondition already fails.
+func (h *Handlers) ifMatch(r *http.Request, id int64) (version int64, ok bool, err error) {
+	header := r.Header.Get("If-Match")
+
+	if header == "" {
+		return 0, true, nil
+	}
+
+	versions, wildcard := parseETags(header, false)
+
+	if wildcard {
+		return 0, true, nil
+	}
+
+	switch len(versions) {
+	case 0:
+		return 0, false, nil
+	case 1:
+		return versions[0], true, nil
+	}
+
+	task, err := h.todo.GetTask(r.Context(), id)
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, v := range versions {
+		if v == task.Version {
+			return v, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// checkIfMatch resolves If-Match for a write handler and answers the request itself when the
+// precondition fails or the task can't be looked up.
+func (h *Handlers) checkIfMatch(w http.ResponseWriter, r *http.Request, id int64) (int64, bool) {
+	version, ok, err := h.ifMatch(r, id)
+
+	if err != nil {
+		writeError(w, r, err)
+		return 0, false
+	}
+
+	if !ok {
+		writeError(w, r, models.ErrVersionMismatch)
+		return 0, false
+	}
+
+	return version, true
+}
+
+// notModified reports whether the If-None-Match header of a read lists the current version.
+func notModified(r *http.Request, version int64) bool {
+	header := r.Header.Get("If-None-Match")
+
+	if header == "" {
+		return false
+	}
+
+	versions, wildcard := parseETags(header, true)
+
+	if wildcard {
+		return true
+	}
+
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,62 +1,154 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 	"todo/api/internal/domain/models"
-	producer "todo/api/internal/kafka"
 
 	"github.com/go-chi/chi/v5"
 )
 
 type Todo interface {
-	CreateTask(name, description string) error
-	GetTask(id int64) (models.Task, error)
-	EditTask(id int64, name, description string) error
-	DeleteTask(id int64) error
-	CompleteTask(id int64) error
-	ListTasks() ([]models.Task, error)
-	ListCompletedTasks() ([]models.Task, error)
-	ListNotCompletedTasks() ([]models.Task, error)
+	CreateTask(ctx context.Context, task models.Task, key string) (models.Task, bool, error)
+	GetTask(ctx context.Context, id int64) (models.Task, error)
+	EditTask(ctx context.Context, task models.Task) (models.Task, error)
+	UpdateTask(ctx context.Context, task models.Task, fields []string) (models.Task, error)
+	DeleteTask(ctx context.Context, id, version int64) error
+	CompleteTask(ctx context.Context, id int64, cascade bool) (models.Task, error)
+	TransitionTask(ctx context.Context, id int64, status string) error
+	ReopenTask(ctx context.Context, id int64) error
+	ListTrash(ctx context.Context) ([]models.Task, error)
+	RestoreTask(ctx context.Context, id int64) error
+	PurgeTask(ctx context.Context, id int64) error
+	ArchiveTask(ctx context.Context, id int64) error
+	UnarchiveTask(ctx context.Context, id int64) error
+	ArchiveCompletedBefore(ctx context.Context, before time.Time) (int64, error)
+	ListArchivedTasks(ctx context.Context) ([]models.Task, error)
+	ListTasks(ctx context.Context, query models.TaskQuery) (models.TaskPage, error)
+	SearchTasks(ctx context.Context, query, language string, limit int32) ([]models.SearchHit, error)
+	ListCompletedTasks(ctx context.Context, limit int32, cursor string) (models.TaskPage, error)
+	ListNotCompletedTasks(ctx context.Context, limit int32, cursor string) (models.TaskPage, error)
+	ListTasksByPriority(ctx context.Context) ([]models.Task, error)
+	ListOverdueTasks(ctx context.Context) ([]models.Task, error)
+	ListDueBetween(ctx context.Context, from, to time.Time) ([]models.Task, error)
+	ListTasksByTags(ctx context.Context, tags []string, matchAll bool) ([]models.Task, error)
+	AttachTag(ctx context.Context, id int64, tag string) error
+	DetachTag(ctx context.Context, id int64, tag string) error
+	ListTags(ctx context.Context) ([]models.Tag, error)
+	ListSubtasks(ctx context.Context, id int64, recursive bool) ([]models.Task, error)
+	MoveTask(ctx context.Context, id int64, parentID *int64) error
+	AddDependency(ctx context.Context, id, blockedByID int64) error
+	RemoveDependency(ctx context.Context, id, blockedByID int64) error
+	ListBlockers(ctx context.Context, id int64) ([]models.Task, error)
+	ListReadyTasks(ctx context.Context) ([]models.Task, error)
+}
+
+type Projects interface {
+	CreateProject(ctx context.Context, project models.Project) (models.Project, error)
+	GetProject(ctx context.Context, id int64) (models.Project, error)
+	EditProject(ctx context.Context, project models.Project) error
+	DeleteProject(ctx context.Context, id int64, cascade bool) error
+	ListProjects(ctx context.Context, includeArchived bool) ([]models.Project, error)
+	ListProjectTasks(ctx context.Context, id int64) ([]models.Task, error)
+}
+
+type Views interface {
+	CreateView(ctx context.Context, view models.View) (models.View, error)
+	GetView(ctx context.Context, id int64, owner string) (models.View, error)
+	EditView(ctx context.Context, view models.View) error
+	DeleteView(ctx context.Context, id int64, owner string) error
+	ListViews(ctx context.Context, owner string) ([]models.View, error)
+	ListViewTasks(ctx context.Context, id int64, owner string, limit int32, cursor string) (models.TaskPage, error)
+}
+
+type Publisher interface {
+	Publish(event string) error
 }
 
 type Handlers struct {
-	producer *producer.Producer
+	producer Publisher
 	todo     Todo
+	projects Projects
+	views    Views
 }
 
-func New(todo Todo, producer *producer.Producer) *Handlers {
+func New(todo Todo, projects Projects, views Views, producer Publisher) *Handlers {
 	return &Handlers{
 		todo:     todo,
+		projects: projects,
+		views:    views,
 		producer: producer,
 	}
 }
 
+// CreateTaskHandler creates a task and answers 201 with it. A request carrying an
+// Idempotency-Key that was already used for the same body replays the original response
+// instead of creating a duplicate; the same key with a different body is rejected with 422.
 func (h *Handlers) CreateTaskHandler(w http.ResponseWriter, r *http.Request) {
+	key := r.Header.Get("Idempotency-Key")
+
+	if !validIdempotencyKey(key) {
+		writeProblem(w, r, http.StatusBadRequest, "invalid Idempotency-Key")
+		return
+	}
+
 	var req struct {
-		Name        string `json:"name"`
-		Description string `json:"description"`
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		Priority    string     `json:"priority"`
+		DueAt       *time.Time `json:"due_at"`
+		ParentId    *int64     `json:"parent_id"`
+		Recurrence  string     `json:"recurrence"`
+		ProjectId   *int64     `json:"project_id"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "invalid request", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "invalid request")
 		return
 	}
 
-	if err := h.todo.CreateTask(req.Name, req.Description); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	if pidStr := chi.URLParam(r, "pid"); pidStr != "" {
+		pid, err := strconv.ParseInt(pidStr, 10, 64)
+
+		if err != nil {
+			writeProblem(w, r, http.StatusBadRequest, "invalid project id")
+			return
+		}
+
+		req.ProjectId = &pid
+	}
+
+	task := models.Task{
+		Name:        req.Name,
+		Description: req.Description,
+		Priority:    req.Priority,
+		DueAt:       req.DueAt,
+		ParentId:    req.ParentId,
+		Recurrence:  req.Recurrence,
+		ProjectId:   req.ProjectId,
+	}
+
+	task, replayed, err := h.todo.CreateTask(r.Context(), task, key)
+
+	if err != nil {
+		writeError(w, r, err)
 		return
 	}
 
-	_ = h.producer.Publish(
-		fmt.Sprintf("time=%s action=create_task name=%s",
-			time.Now().Format(time.RFC3339), req.Name),
-	)
+	if replayed {
+		w.Header().Set("Idempotent-Replayed", "true")
+	} else {
+		_ = h.producer.Publish(
+			fmt.Sprintf("time=%s action=create_task id=%d name=%s",
+				time.Now().Format(time.RFC3339), task.Id, task.Name),
+		)
+	}
 
-	w.WriteHeader(http.StatusCreated)
+	writeTask(w, http.StatusCreated, task)
 }
 
 func (h *Handlers) GetTaskHandler(w http.ResponseWriter, r *http.Request) {
@@ -64,17 +156,38 @@ func (h *Handlers) GetTaskHandler(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseInt(idStr, 10, 64)
 
 	if err != nil {
-		http.Error(w, "invalid id", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
 		return
 	}
 
-	task, err := h.todo.GetTask(id)
+	task, err := h.todo.GetTask(r.Context(), id)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+		writeError(w, r, err)
 		return
 	}
 
+	// The version only covers the task itself, so an expanded tree gets no ETag.
+	if r.URL.Query().Get("expand") != "subtasks" {
+		w.Header().Set("ETag", etag(task.Version))
+
+		if notModified(r, task.Version) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	if r.URL.Query().Get("expand") == "subtasks" {
+		subtasks, err := h.todo.ListSubtasks(r.Context(), id, true)
+
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		task.Subtasks = models.NestSubtasks(id, subtasks)
+	}
+
 	_ = h.producer.Publish(
 		fmt.Sprintf("time=%s action=get_task id=%d",
 			time.Now().Format(time.RFC3339), id),
@@ -83,36 +196,62 @@ func (h *Handlers) GetTaskHandler(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(task)
 }
 
+// EditTaskHandler replaces the writable fields of a task. name is required; every other field
+// (description, priority, due_at, recurrence, project_id) keeps the stored value when it is
+// omitted, null or empty. PATCH with null is the way to clear one of them.
 func (h *Handlers) EditTaskHandler(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
 
 	if err != nil {
-		http.Error(w, "invalid id", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	var req struct {
-		Name        string `json:"name"`
-		Description string `json:"description"`
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		Priority    string     `json:"priority"`
+		DueAt       *time.Time `json:"due_at"`
+		Recurrence  string     `json:"recurrence"`
+		ProjectId   *int64     `json:"project_id"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "invalid request", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	task := models.Task{
+		Id:          id,
+		Name:        req.Name,
+		Description: req.Description,
+		Priority:    req.Priority,
+		DueAt:       req.DueAt,
+		Recurrence:  req.Recurrence,
+		ProjectId:   req.ProjectId,
+	}
+
+	version, ok := h.checkIfMatch(w, r, id)
+
+	if !ok {
 		return
 	}
 
-	if err := h.todo.EditTask(id, req.Name, req.Description); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	task.Version = version
+	task, err = h.todo.EditTask(r.Context(), task)
+
+	if err != nil {
+		writeError(w, r, err)
 		return
 	}
 
 	_ = h.producer.Publish(
 		fmt.Sprintf("time=%s action=edit_task id=%d name=%s",
-			time.Now().Format(time.RFC3339), id, req.Name),
+			time.Now().Format(time.RFC3339), id, task.Name),
 	)
 
-	w.WriteHeader(http.StatusOK)
+	writeTask(w, http.StatusOK, task)
 }
 
 func (h *Handlers) DeleteTaskHandler(w http.ResponseWriter, r *http.Request) {
@@ -120,12 +259,18 @@ func (h *Handlers) DeleteTaskHandler(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseInt(idStr, 10, 64)
 
 	if err != nil {
-		http.Error(w, "invalid id", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
 		return
 	}
 
-	if err := h.todo.DeleteTask(id); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	version, ok := h.checkIfMatch(w, r, id)
+
+	if !ok {
+		return
+	}
+
+	if err := h.todo.DeleteTask(r.Context(), id, version); err != nil {
+		writeError(w, r, err)
 		return
 	}
 
@@ -142,17 +287,79 @@ func (h *Handlers) CompleteTaskHandler(w http.ResponseWriter, r *http.Request) {
 	id, err := strconv.ParseInt(idStr, 10, 64)
 
 	if err != nil {
-		http.Error(w, "invalid id", http.StatusBadRequest)
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	cascade := r.URL.Query().Get("cascade") == "true"
+
+	task, err := h.todo.CompleteTask(r.Context(), id, cascade)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=complete_task id=%d cascade=%t",
+			time.Now().Format(time.RFC3339), id, cascade),
+	)
+
+	writeTask(w, http.StatusOK, task)
+}
+
+func (h *Handlers) TransitionTaskHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req struct {
+		Status string `json:"status"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	if !models.ValidStatus(req.Status) {
+		writeProblem(w, r, http.StatusBadRequest, "invalid status")
+		return
+	}
+
+	if err := h.todo.TransitionTask(r.Context(), id, req.Status); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=transition_task id=%d status=%s",
+			time.Now().Format(time.RFC3339), id, req.Status),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) ReopenTaskHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
 		return
 	}
 
-	if err := h.todo.CompleteTask(id); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	if err := h.todo.ReopenTask(r.Context(), id); err != nil {
+		writeError(w, r, err)
 		return
 	}
 
 	_ = h.producer.Publish(
-		fmt.Sprintf("time=%s action=complete_task id=%d",
+		fmt.Sprintf("time=%s action=reopen_task id=%d",
 			time.Now().Format(time.RFC3339), id),
 	)
 
@@ -160,49 +367,475 @@ func (h *Handlers) CompleteTaskHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handlers) ListTasksHandler(w http.ResponseWriter, r *http.Request) {
-	tasks, err := h.todo.ListTasks()
+	query := r.URL.Query()
+
+	taskQuery, problems := parseTaskQuery(r)
+
+	if len(problems) > 0 {
+		writeValidationProblem(w, r, "invalid query parameters", problems)
+		return
+	}
+
+	list := func() (models.TaskPage, error) {
+		return h.todo.ListTasks(r.Context(), taskQuery)
+	}
+
+	// The priority and tag lists aren't paginated, so a page of them can't be asked for.
+	paged := query.Has("limit") || query.Has("cursor")
+
+	if query.Get("order") == "priority" {
+		if paged {
+			writeProblem(w, r, http.StatusBadRequest, "order=priority can't be combined with limit or cursor")
+			return
+		}
+
+		list = func() (models.TaskPage, error) {
+			tasks, err := h.todo.ListTasksByPriority(r.Context())
+
+			return models.TaskPage{Items: tasks}, err
+		}
+	}
+
+	if len(query["tag"]) > 0 {
+		if paged {
+			writeProblem(w, r, http.StatusBadRequest, "tag can't be combined with limit or cursor")
+			return
+		}
+
+		tags := make([]string, 0, len(query["tag"]))
+
+		for _, v := range query["tag"] {
+			tag, ok := models.NormalizeTag(v)
+
+			if !ok {
+				writeProblem(w, r, http.StatusBadRequest, "invalid tag")
+				return
+			}
+
+			tags = append(tags, tag)
+		}
+
+		match := query.Get("match")
+
+		if match != "" && match != "all" && match != "any" {
+			writeProblem(w, r, http.StatusBadRequest, "invalid match")
+			return
+		}
+
+		list = func() (models.TaskPage, error) {
+			tasks, err := h.todo.ListTasksByTags(r.Context(), tags, match != "any")
+
+			return models.TaskPage{Items: tasks}, err
+		}
+	}
+
+	page, err := list()
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, r, err)
 		return
 	}
 
 	_ = h.producer.Publish(
 		fmt.Sprintf("time=%s action=list_tasks count=%d",
-			time.Now().Format(time.RFC3339), len(tasks)),
+			time.Now().Format(time.RFC3339), len(page.Items)),
 	)
 
-	_ = json.NewEncoder(w).Encode(tasks)
+	writePage(w, page)
 }
 
 func (h *Handlers) ListCompletedTasksHandler(w http.ResponseWriter, r *http.Request) {
-	tasks, err := h.todo.ListCompletedTasks()
+	limit, cursor, err := parsePage(r)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.todo.ListCompletedTasks(r.Context(), limit, cursor)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, r, err)
 		return
 	}
 
 	_ = h.producer.Publish(
 		fmt.Sprintf("time=%s action=list_completed_tasks count=%d",
-			time.Now().Format(time.RFC3339), len(tasks)),
+			time.Now().Format(time.RFC3339), len(page.Items)),
 	)
 
-	_ = json.NewEncoder(w).Encode(tasks)
+	writePage(w, page)
 }
 
 func (h *Handlers) ListNotCompletedTasksHandler(w http.ResponseWriter, r *http.Request) {
-	tasks, err := h.todo.ListNotCompletedTasks()
+	limit, cursor, err := parsePage(r)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.todo.ListNotCompletedTasks(r.Context(), limit, cursor)
+
+	if err != nil {
+		writeError(w, r, err)
 		return
 	}
 
 	_ = h.producer.Publish(
 		fmt.Sprintf("time=%s action=list_not_completed_tasks count=%d",
+			time.Now().Format(time.RFC3339), len(page.Items)),
+	)
+
+	writePage(w, page)
+}
+
+func (h *Handlers) ListOverdueTasksHandler(w http.ResponseWriter, r *http.Request) {
+	tasks, err := h.todo.ListOverdueTasks(r.Context())
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=list_overdue_tasks count=%d",
+			time.Now().Format(time.RFC3339), len(tasks)),
+	)
+
+	writeTasks(w, tasks)
+}
+
+func (h *Handlers) ListDueBetweenHandler(w http.ResponseWriter, r *http.Request) {
+	from, err := time.Parse(time.RFC3339, r.URL.Query().Get("from"))
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid from")
+		return
+	}
+
+	to, err := time.Parse(time.RFC3339, r.URL.Query().Get("to"))
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid to")
+		return
+	}
+
+	if !to.After(from) {
+		writeProblem(w, r, http.StatusBadRequest, "to must be after from")
+		return
+	}
+
+	tasks, err := h.todo.ListDueBetween(r.Context(), from, to)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=list_due_tasks from=%s to=%s count=%d",
+			time.Now().Format(time.RFC3339), from.Format(time.RFC3339), to.Format(time.RFC3339), len(tasks)),
+	)
+
+	writeTasks(w, tasks)
+}
+
+func (h *Handlers) AttachTagHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req struct {
+		Tag string `json:"tag"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	tag, ok := models.NormalizeTag(req.Tag)
+
+	if !ok {
+		writeProblem(w, r, http.StatusBadRequest, "invalid tag")
+		return
+	}
+
+	if err := h.todo.AttachTag(r.Context(), id, tag); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=attach_tag id=%d tag=%s",
+			time.Now().Format(time.RFC3339), id, tag),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) DetachTagHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	tag, ok := models.NormalizeTag(chi.URLParam(r, "tag"))
+
+	if !ok {
+		writeProblem(w, r, http.StatusBadRequest, "invalid tag")
+		return
+	}
+
+	if err := h.todo.DetachTag(r.Context(), id, tag); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=detach_tag id=%d tag=%s",
+			time.Now().Format(time.RFC3339), id, tag),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) ListTagsHandler(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.todo.ListTags(r.Context())
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=list_tags count=%d",
+			time.Now().Format(time.RFC3339), len(tags)),
+	)
+
+	_ = json.NewEncoder(w).Encode(tags)
+}
+
+func (h *Handlers) ListSubtasksHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	expand := r.URL.Query().Get("expand") == "subtasks"
+
+	tasks, err := h.todo.ListSubtasks(r.Context(), id, expand)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if expand {
+		tasks = models.NestSubtasks(id, tasks)
+	}
+
+	if tasks == nil {
+		tasks = []models.Task{}
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=list_subtasks id=%d count=%d",
+			time.Now().Format(time.RFC3339), id, len(tasks)),
+	)
+
+	writeTasks(w, tasks)
+}
+
+func (h *Handlers) MoveTaskHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req struct {
+		ParentId *int64 `json:"parent_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	if req.ParentId != nil && (*req.ParentId < 1 || *req.ParentId == id) {
+		writeProblem(w, r, http.StatusBadRequest, "invalid parent_id")
+		return
+	}
+
+	if err := h.todo.MoveTask(r.Context(), id, req.ParentId); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=move_task id=%d parent_id=%d",
+			time.Now().Format(time.RFC3339), id, fromID(req.ParentId)),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) AddDependencyHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req struct {
+		BlockedById int64 `json:"blocked_by_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	if req.BlockedById < 1 || req.BlockedById == id {
+		writeProblem(w, r, http.StatusBadRequest, "invalid blocked_by_id")
+		return
+	}
+
+	if err := h.todo.AddDependency(r.Context(), id, req.BlockedById); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=add_dependency id=%d blocked_by_id=%d",
+			time.Now().Format(time.RFC3339), id, req.BlockedById),
+	)
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handlers) RemoveDependencyHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	blockerStr := chi.URLParam(r, "blockerId")
+	blockedByID, err := strconv.ParseInt(blockerStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid blocker id")
+		return
+	}
+
+	if err := h.todo.RemoveDependency(r.Context(), id, blockedByID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=remove_dependency id=%d blocked_by_id=%d",
+			time.Now().Format(time.RFC3339), id, blockedByID),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) ListBlockersHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	tasks, err := h.todo.ListBlockers(r.Context(), id)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if tasks == nil {
+		tasks = []models.Task{}
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=list_blockers id=%d count=%d",
+			time.Now().Format(time.RFC3339), id, len(tasks)),
+	)
+
+	writeTasks(w, tasks)
+}
+
+func (h *Handlers) ListReadyTasksHandler(w http.ResponseWriter, r *http.Request) {
+	tasks, err := h.todo.ListReadyTasks(r.Context())
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=list_ready_tasks count=%d",
 			time.Now().Format(time.RFC3339), len(tasks)),
 	)
 
-	_ = json.NewEncoder(w).Encode(tasks)
+	writeTasks(w, tasks)
+}
+
+// writeTask answers with a task, its URL in Location and its version as the ETag.
+func writeTask(w http.ResponseWriter, code int, task models.Task) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/api/v1/todos/%d", task.Id))
+	w.Header().Set("ETag", etag(task.Version))
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(task)
+}
+
+func fromID(id *int64) int64 {
+	if id == nil {
+		return 0
+	}
+
+	return *id
+}
+
+const maxIdempotencyKey = 255
+
+// validIdempotencyKey reports whether an Idempotency-Key header is absent or a short run of
+// printable ASCII, which is what clients generate (usually a UUID).
+func validIdempotencyKey(key string) bool {
+	if len(key) > maxIdempotencyKey {
+		return false
+	}
+
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x21 || key[i] > 0x7e {
+			return false
+		}
+	}
+
+	return true
 }
@@ -2,18 +2,26 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 	"todo/api/internal/domain/models"
 	"todo/api/internal/http/handlers"
-	producer "todo/api/internal/kafka"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type fakeProducer struct {
-	writer   *producer.Producer
 	messages []string
 }
 
@@ -24,39 +32,301 @@ func (f *fakeProducer) Publish(event string) error {
 
 func (f *fakeProducer) Close() error { return nil }
 
-type fakeTodo struct{}
+type fakeTodo struct {
+	tags     []string
+	matchAll bool
+	created  models.Task
+	query    models.TaskQuery
+	language string
+	patched  models.Task
+	edited   models.Task
+	fields   []string
+	keys     map[string]models.Task
+	ctx      context.Context
+}
+
+func (f *fakeTodo) CreateTask(_ context.Context, task models.Task, key string) (models.Task, bool, error) {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if task.Name == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: "title", Description: "is required"})
+	}
+
+	if task.Priority == "asap" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: "priority", Description: "is not a valid priority"})
+	}
+
+	if task.Recurrence == "FREQ=HOURLY" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: "recurrence", Description: "unsupported FREQ"})
+	}
+
+	if task.ParentId != nil && *task.ParentId < 1 {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: "parent_id", Description: "must be a positive integer"})
+	}
+
+	if task.ProjectId != nil && *task.ProjectId < 1 {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: "project_id", Description: "must be a positive integer"})
+	}
+
+	if len(violations) > 0 {
+		st, _ := status.New(codes.InvalidArgument, "invalid arguments").WithDetails(&errdetails.BadRequest{
+			FieldViolations: violations,
+		})
+
+		return models.Task{}, false, fmt.Errorf("client.CreateTask: %w", st.Err())
+	}
+
+	if first, ok := f.keys[key]; ok && key != "" {
+		if first.Name != task.Name || first.Description != task.Description {
+			return models.Task{}, false, models.ErrIdempotencyMismatch
+		}
+
+		return first, true, nil
+	}
+
+	task.Id = int64(len(f.keys) + 100)
+	f.created = task
+
+	if key != "" {
+		if f.keys == nil {
+			f.keys = map[string]models.Task{}
+		}
+
+		f.keys[key] = task
+	}
+
+	return task, false, nil
+}
+
+func (f *fakeTodo) GetTask(ctx context.Context, id int64) (models.Task, error) {
+	f.ctx = ctx
+
+	if id == 404 {
+		return models.Task{}, fmt.Errorf("client.GetTask: %w", status.Error(codes.NotFound, "storage: not found"))
+	}
+
+	return models.Task{Id: id, Name: "Task", Description: "Desc", CreatedAt: time.Now(), Version: 3}, nil
+}
+
+func (f *fakeTodo) EditTask(_ context.Context, task models.Task) (models.Task, error) {
+	if task.Id == 400 {
+		st, _ := status.New(codes.InvalidArgument, "invalid arguments").WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "title", Description: "is required"}},
+		})
+
+		return models.Task{}, fmt.Errorf("client.EditTask: %w", st.Err())
+	}
+
+	if task.Version != 0 && task.Version != 3 {
+		return models.Task{}, models.ErrVersionMismatch
+	}
+
+	f.edited = task
+	task.Version = 4
+
+	return task, nil
+}
+
+func (f *fakeTodo) UpdateTask(_ context.Context, task models.Task, fields []string) (models.Task, error) {
+	f.patched, f.fields = task, fields
+	return task, nil
+}
+
+func (f *fakeTodo) DeleteTask(_ context.Context, id, version int64) error {
+	if version != 0 && version != 3 {
+		return models.ErrVersionMismatch
+	}
+
+	return nil
+}
+
+func (f *fakeTodo) CompleteTask(_ context.Context, id int64, cascade bool) (models.Task, error) {
+	return models.Task{Id: id, Name: "Task", Completed: true, Status: models.StatusDone, Version: 4}, nil
+}
+
+func (f *fakeTodo) TransitionTask(_ context.Context, id int64, status string) error { return nil }
+
+func (f *fakeTodo) ReopenTask(_ context.Context, id int64) error {
+	if id == 2 {
+		return fmt.Errorf("client.ReopenTask: %w", status.Error(codes.FailedPrecondition, "task is still open"))
+	}
+
+	return nil
+}
+
+func (f *fakeTodo) ListTrash(_ context.Context) ([]models.Task, error) { return nil, nil }
+
+func (f *fakeTodo) ArchiveTask(_ context.Context, id int64) error { return nil }
+
+func (f *fakeTodo) UnarchiveTask(_ context.Context, id int64) error { return nil }
+
+func (f *fakeTodo) ArchiveCompletedBefore(_ context.Context, before time.Time) (int64, error) {
+	return 2, nil
+}
+
+func (f *fakeTodo) ListArchivedTasks(_ context.Context) ([]models.Task, error) {
+	return []models.Task{{Id: 5, Name: "Old", Completed: true}}, nil
+}
+
+func (f *fakeTodo) RestoreTask(_ context.Context, id int64) error { return nil }
+
+func (f *fakeTodo) PurgeTask(_ context.Context, id int64) error { return nil }
 
-func (f *fakeTodo) CreateTask(name, description string) error { return nil }
+func (f *fakeTodo) ListTasks(_ context.Context, query models.TaskQuery) (models.TaskPage, error) {
+	f.query = query
 
-func (f *fakeTodo) GetTask(id int64) (models.Task, error) {
-	return models.Task{Id: id, Name: "Task", Description: "Desc", CreatedAt: time.Now()}, nil
+	if query.Filter == "titel:x" {
+		return models.TaskPage{}, &models.FilterError{Pos: 0, End: 5, Message: `unknown field "titel"`}
+	}
+
+	return models.TaskPage{Items: []models.Task{{Id: 1, Name: "Task"}}, NextCursor: "next"}, nil
+}
+
+func (f *fakeTodo) SearchTasks(_ context.Context, query, language string, limit int32) ([]models.SearchHit, error) {
+	f.query.Q = query
+	f.language = language
+
+	return []models.SearchHit{{Task: models.Task{Id: 1, Name: "Deploy"}, Rank: 0.5, Snippet: "<b>deploy</b> the app"}}, nil
+}
+
+func (f *fakeTodo) ListCompletedTasks(_ context.Context, limit int32, cursor string) (models.TaskPage, error) {
+	return models.TaskPage{Items: []models.Task{{Id: 1, Name: "Task"}}}, nil
+}
+
+func (f *fakeTodo) ListNotCompletedTasks(_ context.Context, limit int32, cursor string) (models.TaskPage, error) {
+	return models.TaskPage{Items: []models.Task{{Id: 2, Name: "Task2"}}}, nil
+}
+
+func (f *fakeTodo) ListTasksByPriority(_ context.Context) ([]models.Task, error) {
+	return []models.Task{{Id: 3, Name: "Urgent", Priority: models.PriorityUrgent}}, nil
+}
+
+func (f *fakeTodo) ListOverdueTasks(_ context.Context) ([]models.Task, error) {
+	return []models.Task{{Id: 4, Name: "Late", Overdue: true}}, nil
+}
+
+func (f *fakeTodo) ListDueBetween(_ context.Context, from, to time.Time) ([]models.Task, error) {
+	return []models.Task{{Id: 5, Name: "Soon"}}, nil
+}
+
+func (f *fakeTodo) ListTasksByTags(_ context.Context, tags []string, matchAll bool) ([]models.Task, error) {
+	f.tags, f.matchAll = tags, matchAll
+
+	if slices.Contains(tags, "unused") {
+		return nil, nil
+	}
+
+	return []models.Task{{Id: 6, Name: "Tagged", Tags: tags}}, nil
+}
+
+func (f *fakeTodo) AttachTag(_ context.Context, id int64, tag string) error { return nil }
+
+func (f *fakeTodo) DetachTag(_ context.Context, id int64, tag string) error { return nil }
+
+func (f *fakeTodo) ListTags(_ context.Context) ([]models.Tag, error) {
+	return []models.Tag{{Name: "backend", Count: 2}}, nil
+}
+
+func (f *fakeTodo) ListSubtasks(_ context.Context, id int64, recursive bool) ([]models.Task, error) {
+	child, grandchild := int64(7), int64(8)
+
+	return []models.Task{
+		{Id: child, Name: "Child", ParentId: &id},
+		{Id: grandchild, Name: "Grandchild", ParentId: &child},
+	}, nil
+}
+
+func (f *fakeTodo) MoveTask(_ context.Context, id int64, parentID *int64) error { return nil }
+
+func (f *fakeTodo) AddDependency(_ context.Context, id, blockedByID int64) error { return nil }
+
+func (f *fakeTodo) RemoveDependency(_ context.Context, id, blockedByID int64) error { return nil }
+
+func (f *fakeTodo) ListBlockers(_ context.Context, id int64) ([]models.Task, error) { return nil, nil }
+
+func (f *fakeTodo) ListReadyTasks(_ context.Context) ([]models.Task, error) {
+	return []models.Task{{Id: 9, Name: "Ready"}}, nil
+}
+
+type fakeProjects struct{}
+
+func (f *fakeProjects) CreateProject(_ context.Context, project models.Project) (models.Project, error) {
+	project.Id = 1
+	return project, nil
+}
+
+func (f *fakeProjects) GetProject(_ context.Context, id int64) (models.Project, error) {
+	return models.Project{Id: id, Name: "Inbox"}, nil
+}
+
+func (f *fakeProjects) EditProject(_ context.Context, project models.Project) error { return nil }
+
+func (f *fakeProjects) DeleteProject(_ context.Context, id int64, cascade bool) error { return nil }
+
+func (f *fakeProjects) ListProjects(_ context.Context, includeArchived bool) ([]models.Project, error) {
+	return []models.Project{{Id: 1, Name: "Inbox"}}, nil
+}
+
+func (f *fakeProjects) ListProjectTasks(_ context.Context, id int64) ([]models.Task, error) {
+	return nil, nil
+}
+
+type fakeViews struct {
+	created models.View
+}
+
+func (f *fakeViews) CreateView(_ context.Context, view models.View) (models.View, error) {
+	if view.Name == "Taken" {
+		return models.View{}, models.ErrViewExists
+	}
+
+	if view.Criteria.Filter == "titel:x" {
+		return models.View{}, &models.FilterError{Pos: 0, End: 5, Message: `unknown field "titel"`}
+	}
+
+	view.Id = 1
+	f.created = view
+
+	return view, nil
+}
+
+func (f *fakeViews) GetView(_ context.Context, id int64, owner string) (models.View, error) {
+	if owner != "alice" {
+		return models.View{}, fmt.Errorf("client.GetView: %w", status.Error(codes.NotFound, "storage: not found"))
+	}
+
+	return models.View{Id: id, Owner: owner, Name: "Pending"}, nil
 }
 
-func (f *fakeTodo) EditTask(id int64, name, description string) error { return nil }
+func (f *fakeViews) EditView(_ context.Context, view models.View) error { return nil }
 
-func (f *fakeTodo) DeleteTask(id int64) error { return nil }
+func (f *fakeViews) DeleteView(_ context.Context, id int64, owner string) error { return nil }
 
-func (f *fakeTodo) CompleteTask(id int64) error { return nil }
+func (f *fakeViews) ListViews(_ context.Context, owner string) ([]models.View, error) {
+	return nil, nil
+}
 
-func (f *fakeTodo) ListTasks() ([]models.Task, error) {
-	return []models.Task{{Id: 1, Name: "Task"}}, nil
+func (f *fakeViews) ListViewTasks(_ context.Context, id int64, owner string, limit int32, cursor string) (models.TaskPage, error) {
+	return models.TaskPage{Items: []models.Task{{Id: 5, Name: "Task"}}, NextCursor: "next"}, nil
 }
 
-func (f *fakeTodo) ListCompletedTasks() ([]models.Task, error) {
-	return []models.Task{{Id: 1, Name: "Task"}}, nil
+func withID(r *http.Request, id string) *http.Request {
+	return withParam(r, "id", id)
 }
 
-func (f *fakeTodo) ListNotCompletedTasks() ([]models.Task, error) {
-	return []models.Task{{Id: 2, Name: "Task2"}}, nil
+func withParam(r *http.Request, key, value string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(key, value)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
 }
 
 func TestHandlers(t *testing.T) {
 	todo := &fakeTodo{}
-	prod := &fakeProducer{
-		writer: nil,
-	}
+	prod := &fakeProducer{}
 
-	h := handlers.New(todo, prod.writer)
+	views := &fakeViews{}
+	h := handlers.New(todo, &fakeProjects{}, views, prod)
 
 	// CreateTask
 	{
@@ -74,11 +344,112 @@ func TestHandlers(t *testing.T) {
 		if len(prod.messages) != 1 {
 			t.Fatalf("CreateTaskHandler: ожидалось 1 сообщение, получили %d", len(prod.messages))
 		}
+
+		var task models.Task
+		_ = json.NewDecoder(w.Body).Decode(&task)
+
+		if task.Id == 0 || w.Result().Header.Get("Location") != fmt.Sprintf("/api/v1/todos/%d", task.Id) {
+			t.Fatalf("CreateTaskHandler: ожидалась созданная задача с Location, получили %+v %q", task, w.Result().Header.Get("Location"))
+		}
+	}
+
+	// CreateTask retried with an Idempotency-Key
+	{
+		send := func(body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(body))
+			req.Header.Set("Idempotency-Key", "4f1c2b1e-retry")
+			w := httptest.NewRecorder()
+
+			h.CreateTaskHandler(w, req)
+
+			return w
+		}
+
+		published := len(prod.messages)
+		first := send(`{"name":"Once","description":"Desc"}`)
+		retry := send(`{"description":"Desc","name":"Once"}`)
+
+		var a, b models.Task
+		_ = json.NewDecoder(first.Body).Decode(&a)
+		_ = json.NewDecoder(retry.Body).Decode(&b)
+
+		if retry.Result().StatusCode != http.StatusCreated || a.Id == 0 || a.Id != b.Id {
+			t.Fatalf("CreateTaskHandler: повтор должен вернуть ту же задачу с 201, получили %d %d/%d", retry.Result().StatusCode, a.Id, b.Id)
+		}
+
+		if retry.Result().Header.Get("Idempotent-Replayed") != "true" || len(prod.messages) != published+1 {
+			t.Fatalf("CreateTaskHandler: повтор не должен публиковать событие, сообщений %d", len(prod.messages)-published)
+		}
+
+		if w := send(`{"name":"Other","description":"Desc"}`); w.Result().StatusCode != http.StatusUnprocessableEntity {
+			t.Fatalf("CreateTaskHandler: ожидался 422 для другого тела, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// CreateTask with a recurrence db-service rejects
+	{
+		body := map[string]string{"name": "Standup", "description": "Daily", "recurrence": "FREQ=HOURLY"}
+		b, _ := json.Marshal(body)
+		req := httptest.NewRequest(http.MethodPost, "/tasks", bytes.NewReader(b))
+		w := httptest.NewRecorder()
+
+		h.CreateTaskHandler(w, req)
+
+		var resp struct {
+			Errors []map[string]string `json:"errors"`
+		}
+		_ = json.NewDecoder(w.Body).Decode(&resp)
+
+		if w.Result().StatusCode != http.StatusBadRequest || len(resp.Errors) != 1 || resp.Errors[0]["field"] != "recurrence" {
+			t.Fatalf("CreateTaskHandler: ожидалась ошибка поля recurrence, получили %d %v", w.Result().StatusCode, resp.Errors)
+		}
+	}
+
+	// CreateTask inside a project
+	{
+		body := map[string]string{"name": "Test", "description": "Desc"}
+		b, _ := json.Marshal(body)
+		req := withParam(httptest.NewRequest(http.MethodPost, "/projects/3/todos", bytes.NewReader(b)), "pid", "3")
+		w := httptest.NewRecorder()
+
+		h.CreateTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusCreated {
+			t.Fatalf("CreateTaskHandler: ожидался 201, получили %d", w.Result().StatusCode)
+		}
+
+		if todo.created.ProjectId == nil || *todo.created.ProjectId != 3 {
+			t.Fatalf("CreateTaskHandler: ожидался project_id 3, получили %v", todo.created.ProjectId)
+		}
+	}
+
+	// DeleteProject with invalid mode
+	{
+		req := withParam(httptest.NewRequest(http.MethodDelete, "/projects/1?mode=purge", nil), "pid", "1")
+		w := httptest.NewRecorder()
+
+		h.DeleteProjectHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusBadRequest {
+			t.Fatalf("DeleteProjectHandler: ожидался 400, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// ListProjectTasks returns an empty array
+	{
+		req := withParam(httptest.NewRequest(http.MethodGet, "/projects/1/todos", nil), "pid", "1")
+		w := httptest.NewRecorder()
+
+		h.ListProjectTasksHandler(w, req)
+
+		if body := w.Body.String(); body != "[]\n" {
+			t.Fatalf("ListProjectTasksHandler: ожидался пустой массив, получили %q", body)
+		}
 	}
 
 	// GetTask
 	{
-		req := httptest.NewRequest(http.MethodGet, "/tasks/1", nil)
+		req := withID(httptest.NewRequest(http.MethodGet, "/tasks/1", nil), "1")
 		w := httptest.NewRecorder()
 
 		h.GetTaskHandler(w, req)
@@ -86,79 +457,811 @@ func TestHandlers(t *testing.T) {
 		if w.Result().StatusCode != http.StatusOK {
 			t.Fatalf("GetTaskHandler: ожидался 200, получили %d", w.Result().StatusCode)
 		}
+
+		if etag := w.Result().Header.Get("ETag"); etag != `"3"` {
+			t.Fatalf("GetTaskHandler: ожидался ETag \"3\", получили %q", etag)
+		}
 	}
 
-	// EditTask
+	// GetTask with a current If-None-Match
 	{
-		body := map[string]string{"name": "Edited", "description": "Desc2"}
-		b, _ := json.Marshal(body)
-		req := httptest.NewRequest(http.MethodPut, "/tasks/1", bytes.NewReader(b))
+		req := withID(httptest.NewRequest(http.MethodGet, "/tasks/1", nil), "1")
+		req.Header.Set("If-None-Match", `W/"2", W/"3"`)
+		w := httptest.NewRecorder()
+
+		h.GetTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusNotModified || w.Body.Len() != 0 {
+			t.Fatalf("GetTaskHandler: ожидался 304 без тела, получили %d", w.Result().StatusCode)
+		}
+	}
 
+	// EditTask and DeleteTask with a stale If-Match
+	{
+		body := `{"name":"Updated","description":"Changed"}`
+		req := withID(httptest.NewRequest(http.MethodPut, "/tasks/1", strings.NewReader(body)), "1")
+		req.Header.Set("If-Match", `"2"`)
 		w := httptest.NewRecorder()
 
 		h.EditTaskHandler(w, req)
 
-		if w.Result().StatusCode != http.StatusOK {
-			t.Fatalf("EditTaskHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		if w.Result().StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("EditTaskHandler: ожидался 412, получили %d", w.Result().StatusCode)
+		}
+
+		req = withID(httptest.NewRequest(http.MethodDelete, "/tasks/1", nil), "1")
+		req.Header.Set("If-Match", `"2"`)
+		w = httptest.NewRecorder()
+
+		h.DeleteTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("DeleteTaskHandler: ожидался 412, получили %d", w.Result().StatusCode)
 		}
 	}
 
-	// DeleteTask
+	// PatchTask with If-Match listing the current version among others
 	{
-		req := httptest.NewRequest(http.MethodDelete, "/tasks/1", nil)
+		req := withID(httptest.NewRequest(http.MethodPatch, "/tasks/1", strings.NewReader(`{"description":null}`)), "1")
+		req.Header.Set("If-Match", `"1", "3"`)
 		w := httptest.NewRecorder()
 
-		h.DeleteTaskHandler(w, req)
+		h.PatchTaskHandler(w, req)
 
-		if w.Result().StatusCode != http.StatusOK {
-			t.Fatalf("DeleteTaskHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		if w.Result().StatusCode != http.StatusOK || todo.patched.Version != 3 {
+			t.Fatalf("PatchTaskHandler: ожидался 200 с версией 3, получили %d %d", w.Result().StatusCode, todo.patched.Version)
 		}
 	}
 
-	// CompleteTask
+	// PatchTask with a weak If-Match
 	{
-		req := httptest.NewRequest(http.MethodPost, "/tasks/1/complete", nil)
+		req := withID(httptest.NewRequest(http.MethodPatch, "/tasks/1", strings.NewReader(`{}`)), "1")
+		req.Header.Set("If-Match", `W/"3"`)
 		w := httptest.NewRecorder()
-		h.CompleteTaskHandler(w, req)
 
-		if w.Result().StatusCode != http.StatusOK {
-			t.Fatalf("CompleteTaskHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		h.PatchTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("PatchTaskHandler: ожидался 412 для слабого ETag, получили %d", w.Result().StatusCode)
 		}
 	}
 
-	// ListTasks
+	// GetTask with nested subtasks
 	{
-		req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+		req := withID(httptest.NewRequest(http.MethodGet, "/tasks/1?expand=subtasks", nil), "1")
 		w := httptest.NewRecorder()
 
-		h.ListTasksHandler(w, req)
+		h.GetTaskHandler(w, req)
 
-		if w.Result().StatusCode != http.StatusOK {
-			t.Fatalf("ListTasksHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		var task models.Task
+		_ = json.NewDecoder(w.Body).Decode(&task)
+
+		if len(task.Subtasks) != 1 || len(task.Subtasks[0].Subtasks) != 1 || task.Subtasks[0].Subtasks[0].Id != 8 {
+			t.Fatalf("GetTaskHandler: ожидалось дерево подзадач, получили %+v", task.Subtasks)
 		}
 	}
 
-	// ListCompletedTasks
+	// EditTask
 	{
-		req := httptest.NewRequest(http.MethodGet, "/tasks/completed", nil)
+		body := map[string]string{"name": "Edited", "description": "Desc2"}
+		b, _ := json.Marshal(body)
+		req := withID(httptest.NewRequest(http.MethodPut, "/tasks/1", bytes.NewReader(b)), "1")
+
 		w := httptest.NewRecorder()
 
-		h.ListCompletedTasksHandler(w, req)
+		h.EditTaskHandler(w, req)
 
 		if w.Result().StatusCode != http.StatusOK {
-			t.Fatalf("ListCompletedTasksHandler: ожидался 200, получили %d", w.Result().StatusCode)
+			t.Fatalf("EditTaskHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+
+		var task models.Task
+		_ = json.NewDecoder(w.Body).Decode(&task)
+
+		if task.Id != 1 || task.Name != "Edited" || w.Result().Header.Get("ETag") != `"4"` {
+			t.Fatalf("EditTaskHandler: ожидалась изменённая задача с ETag \"4\", получили %+v %q", task, w.Result().Header.Get("ETag"))
+		}
+
+		e := todo.edited
+
+		if e.Description != "Desc2" || e.Priority != "" || e.DueAt != nil || e.Recurrence != "" || e.ProjectId != nil {
+			t.Fatalf("EditTaskHandler: пропущенные поля не должны подменяться, получили %+v", e)
 		}
 	}
 
-	// ListNotCompletedTasks
+	// PatchTask
 	{
-		req := httptest.NewRequest(http.MethodGet, "/tasks/not-completed", nil)
+		body := `{"name":"Renamed","due_at":null,"project_id":4}`
+		req := withID(httptest.NewRequest(http.MethodPatch, "/tasks/1", strings.NewReader(body)), "1")
+		req.Header.Set("Content-Type", "application/merge-patch+json")
 		w := httptest.NewRecorder()
 
-		h.ListNotCompletedTasksHandler(w, req)
+		h.PatchTaskHandler(w, req)
 
 		if w.Result().StatusCode != http.StatusOK {
-			t.Fatalf("ListNotCompletedTasksHandler: ожидался 200, получили %d", w.Result().StatusCode)
+			t.Fatalf("PatchTaskHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+
+		if strings.Join(todo.fields, ",") != "due_at,title,project_id" {
+			t.Fatalf("PatchTaskHandler: неверная маска полей: %v", todo.fields)
+		}
+
+		p := todo.patched
+
+		if p.Id != 1 || p.Name != "Renamed" || p.DueAt != nil || p.ProjectId == nil || *p.ProjectId != 4 {
+			t.Fatalf("PatchTaskHandler: задача изменена неверно: %+v", p)
+		}
+	}
+
+	// PatchTask with invalid fields
+	{
+		body := `{"name":5,"status":"done","colour":"red"}`
+		req := withID(httptest.NewRequest(http.MethodPatch, "/tasks/1", strings.NewReader(body)), "1")
+		w := httptest.NewRecorder()
+
+		h.PatchTaskHandler(w, req)
+
+		var resp struct {
+			Errors []map[string]string `json:"errors"`
+		}
+
+		_ = json.NewDecoder(w.Body).Decode(&resp)
+
+		if w.Result().StatusCode != http.StatusBadRequest || len(resp.Errors) != 3 {
+			t.Fatalf("PatchTaskHandler: ожидался 400 с 3 ошибками, получили %d %v", w.Result().StatusCode, resp.Errors)
+		}
+	}
+
+	// PatchTask with an empty patch returns the task and publishes nothing
+	{
+		req := withID(httptest.NewRequest(http.MethodPatch, "/tasks/1", strings.NewReader(`{}`)), "1")
+		w := httptest.NewRecorder()
+		published := len(prod.messages)
+
+		h.PatchTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK || len(prod.messages) != published {
+			t.Fatalf("PatchTaskHandler: ожидался 200 без события, получили %d %v", w.Result().StatusCode, prod.messages[published:])
+		}
+	}
+
+	// PatchTask with unsupported content type
+	{
+		req := withID(httptest.NewRequest(http.MethodPatch, "/tasks/1", strings.NewReader(`{}`)), "1")
+		req.Header.Set("Content-Type", "text/plain")
+		w := httptest.NewRecorder()
+
+		h.PatchTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusUnsupportedMediaType {
+			t.Fatalf("PatchTaskHandler: ожидался 415, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// DeleteTask
+	{
+		req := withID(httptest.NewRequest(http.MethodDelete, "/tasks/1", nil), "1")
+		w := httptest.NewRecorder()
+
+		h.DeleteTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK {
+			t.Fatalf("DeleteTaskHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// CompleteTask
+	{
+		req := withID(httptest.NewRequest(http.MethodPost, "/tasks/1/complete", nil), "1")
+		w := httptest.NewRecorder()
+		h.CompleteTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK {
+			t.Fatalf("CompleteTaskHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+
+		var task models.Task
+		_ = json.NewDecoder(w.Body).Decode(&task)
+
+		if !task.Completed || w.Result().Header.Get("Location") != "/api/v1/todos/1" {
+			t.Fatalf("CompleteTaskHandler: ожидалась выполненная задача с Location, получили %+v %q", task, w.Result().Header.Get("Location"))
+		}
+	}
+
+	// ListTasks
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK {
+			t.Fatalf("ListTasksHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// ListTasks ordered by priority
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks?order=priority", nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		var page models.TaskPage
+		_ = json.NewDecoder(w.Body).Decode(&page)
+
+		if len(page.Items) != 1 || page.Items[0].Priority != models.PriorityUrgent {
+			t.Fatalf("ListTasksHandler: ожидалась сортировка по приоритету, получили %+v", page.Items)
+		}
+	}
+
+	// ListTasks with limit and cursor
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks?limit=10&cursor=abc", nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		var page models.TaskPage
+		_ = json.NewDecoder(w.Body).Decode(&page)
+
+		if todo.query.Limit != 10 || todo.query.Cursor != "abc" {
+			t.Fatalf("ListTasksHandler: ожидались limit=10 cursor=abc, получили limit=%d cursor=%q", todo.query.Limit, todo.query.Cursor)
+		}
+
+		if page.NextCursor != "next" {
+			t.Fatalf("ListTasksHandler: ожидался next_cursor, получили %q", page.NextCursor)
+		}
+	}
+
+	// ListTasks with filters and sort
+	{
+		req := httptest.NewRequest(http.MethodGet,
+			"/tasks?q=milk&completed=false&created_after=2025-01-01&created_before=2025-02-01T00:00:00Z&sort=-created_at,title", nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		q := todo.query
+
+		if w.Result().StatusCode != http.StatusOK || q.Q != "milk" || q.Completed == nil || *q.Completed {
+			t.Fatalf("ListTasksHandler: фильтры не разобраны, получили %d %+v", w.Result().StatusCode, q)
+		}
+
+		if !q.CreatedAfter.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) ||
+			!q.CreatedBefore.Equal(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Fatalf("ListTasksHandler: неверный диапазон дат, получили %+v", q)
+		}
+
+		want := []models.SortKey{{Field: "created_at", Desc: true}, {Field: "title"}}
+
+		if !slices.Equal(q.Sort, want) {
+			t.Fatalf("ListTasksHandler: ожидалась сортировка %+v, получили %+v", want, q.Sort)
+		}
+	}
+
+	// ListTasks with a filter expression
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks?filter=completed%3Afalse+AND+created%3E-7d", nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK || todo.query.Filter != "completed:false AND created>-7d" {
+			t.Fatalf("ListTasksHandler: ожидался переданный фильтр, получили %d %q", w.Result().StatusCode, todo.query.Filter)
+		}
+	}
+
+	// ListTasks with an invalid filter expression
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks?filter=titel%3Ax", nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		var body struct {
+			Position int `json:"position"`
+			End      int `json:"end"`
+		}
+		_ = json.NewDecoder(w.Body).Decode(&body)
+
+		if w.Result().StatusCode != http.StatusBadRequest || body.Position != 0 || body.End != 5 {
+			t.Fatalf("ListTasksHandler: ожидался 400 с позицией ошибки, получили %d %+v", w.Result().StatusCode, body)
+		}
+	}
+
+	// ListTasks with several invalid parameters
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks?completed=maybe&created_after=yesterday&sort=title,owner", nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		var body struct {
+			Errors []map[string]string `json:"errors"`
+		}
+		_ = json.NewDecoder(w.Body).Decode(&body)
+
+		if w.Result().StatusCode != http.StatusBadRequest || len(body.Errors) != 3 {
+			t.Fatalf("ListTasksHandler: ожидался 400 с тремя ошибками, получили %d %v", w.Result().StatusCode, body.Errors)
+		}
+	}
+
+	// SearchTasks
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks/search?q=%22deploy+app%22+rel*&lang=ru", nil)
+		w := httptest.NewRecorder()
+
+		h.SearchTasksHandler(w, req)
+
+		var hits []models.SearchHit
+		_ = json.NewDecoder(w.Body).Decode(&hits)
+
+		if w.Result().StatusCode != http.StatusOK || len(hits) != 1 || hits[0].Snippet == "" {
+			t.Fatalf("SearchTasksHandler: ожидался один результат со сниппетом, получили %d %+v", w.Result().StatusCode, hits)
+		}
+
+		if todo.query.Q != `"deploy app" rel*` || todo.language != "russian" {
+			t.Fatalf("SearchTasksHandler: ожидались q и язык russian, получили %q %q", todo.query.Q, todo.language)
+		}
+	}
+
+	// SearchTasks without q or with unknown lang
+	for _, target := range []string{"/tasks/search", "/tasks/search?q=a&lang=klingon"} {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		w := httptest.NewRecorder()
+
+		h.SearchTasksHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusBadRequest {
+			t.Fatalf("SearchTasksHandler(%s): ожидался 400, получили %d", target, w.Result().StatusCode)
+		}
+	}
+
+	// ListTasks with invalid limit
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks?limit=0", nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusBadRequest {
+			t.Fatalf("ListTasksHandler: ожидался 400, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// CreateTask with invalid priority
+	{
+		body := map[string]string{"name": "Test", "description": "Desc", "priority": "asap"}
+		b, _ := json.Marshal(body)
+		req := httptest.NewRequest(http.MethodPost, "/tasks", bytes.NewReader(b))
+		w := httptest.NewRecorder()
+
+		h.CreateTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusBadRequest {
+			t.Fatalf("CreateTaskHandler: ожидался 400, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// CreateTask relays every field violation from db-service at once
+	{
+		body := map[string]any{
+			"name":       "",
+			"priority":   "asap",
+			"parent_id":  -1,
+			"project_id": 0,
+		}
+		b, _ := json.Marshal(body)
+		req := httptest.NewRequest(http.MethodPost, "/tasks", bytes.NewReader(b))
+		w := httptest.NewRecorder()
+
+		h.CreateTaskHandler(w, req)
+
+		var resp struct {
+			Type   string              `json:"type"`
+			Errors []map[string]string `json:"errors"`
+		}
+		_ = json.NewDecoder(w.Body).Decode(&resp)
+
+		var fields []string
+
+		for _, e := range resp.Errors {
+			fields = append(fields, e["field"])
+		}
+
+		if w.Result().StatusCode != http.StatusBadRequest || resp.Type != "/problems/validation-error" ||
+			!slices.Equal(fields, []string{"name", "priority", "parent_id", "project_id"}) {
+			t.Fatalf("CreateTaskHandler: ожидались 4 ошибки полей, получили %d %+v", w.Result().StatusCode, resp)
+		}
+	}
+
+	// Field violations from db-service become a validation problem
+	{
+		b, _ := json.Marshal(map[string]string{"name": "Task"})
+		req := withID(httptest.NewRequest(http.MethodPut, "/tasks/400", bytes.NewReader(b)), "400")
+		w := httptest.NewRecorder()
+
+		h.EditTaskHandler(w, req)
+
+		var resp struct {
+			Errors []map[string]string `json:"errors"`
+		}
+		_ = json.NewDecoder(w.Body).Decode(&resp)
+
+		if w.Result().StatusCode != http.StatusBadRequest || len(resp.Errors) != 1 || resp.Errors[0]["field"] != "name" {
+			t.Fatalf("EditTaskHandler: ожидалась ошибка поля name, получили %d %+v", w.Result().StatusCode, resp)
+		}
+	}
+
+	// ListTasks filtered by tags
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks?tag=Backend&tag=bug&match=any", nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK {
+			t.Fatalf("ListTasksHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+
+		if len(todo.tags) != 2 || todo.tags[0] != "backend" || todo.matchAll {
+			t.Fatalf("ListTasksHandler: неверный фильтр по тегам %v matchAll=%v", todo.tags, todo.matchAll)
+		}
+	}
+
+	// ListTasks with a filter nothing matches
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks?tag=unused", nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK || strings.TrimSpace(w.Body.String()) != `{"items":[]}` {
+			t.Fatalf("ListTasksHandler: ожидался 200 с пустым списком, получили %d %s", w.Result().StatusCode, w.Body.String())
+		}
+	}
+
+	// ListTasks with invalid tag
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks?tag=a,b", nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusBadRequest {
+			t.Fatalf("ListTasksHandler: ожидался 400, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// ListTasks pagination of the unpaginated lists
+	for _, target := range []string{"/tasks?order=priority&limit=10", "/tasks?tag=bug&cursor=abc"} {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		w := httptest.NewRecorder()
+
+		h.ListTasksHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusBadRequest {
+			t.Fatalf("ListTasksHandler(%s): ожидался 400, получили %d", target, w.Result().StatusCode)
+		}
+	}
+
+	// AddDependency on itself
+	{
+		b, _ := json.Marshal(map[string]int64{"blocked_by_id": 1})
+		req := withID(httptest.NewRequest(http.MethodPost, "/tasks/1/blockers", bytes.NewReader(b)), "1")
+		w := httptest.NewRecorder()
+
+		h.AddDependencyHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusBadRequest {
+			t.Fatalf("AddDependencyHandler: ожидался 400, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// ListBlockers returns an empty array
+	{
+		req := withID(httptest.NewRequest(http.MethodGet, "/tasks/1/blockers", nil), "1")
+		w := httptest.NewRecorder()
+
+		h.ListBlockersHandler(w, req)
+
+		if body := w.Body.String(); body != "[]\n" {
+			t.Fatalf("ListBlockersHandler: ожидался пустой массив, получили %q", body)
+		}
+	}
+
+	// ArchiveCompleted
+	{
+		req := httptest.NewRequest(http.MethodPost, "/tasks/archive?before=2025-01-01T00:00:00Z", nil)
+		w := httptest.NewRecorder()
+
+		h.ArchiveCompletedHandler(w, req)
+
+		if body := w.Body.String(); body != "{\"archived\":2}\n" {
+			t.Fatalf("ArchiveCompletedHandler: ожидалось archived=2, получили %q", body)
+		}
+	}
+
+	// ArchiveCompleted without before
+	{
+		req := httptest.NewRequest(http.MethodPost, "/tasks/archive", nil)
+		w := httptest.NewRecorder()
+
+		h.ArchiveCompletedHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusBadRequest {
+			t.Fatalf("ArchiveCompletedHandler: ожидался 400, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// ListTrash returns an empty array and publishes nothing
+	{
+		req := httptest.NewRequest(http.MethodGet, "/trash", nil)
+		w := httptest.NewRecorder()
+		published := len(prod.messages)
+
+		h.ListTrashHandler(w, req)
+
+		if body := w.Body.String(); body != "[]\n" {
+			t.Fatalf("ListTrashHandler: ожидался пустой массив, получили %q", body)
+		}
+
+		if len(prod.messages) != published {
+			t.Fatalf("ListTrashHandler: чтение корзины не должно публиковать событие, получили %v", prod.messages[published:])
+		}
+	}
+
+	// RestoreTask
+	{
+		req := withID(httptest.NewRequest(http.MethodPost, "/trash/1/restore", nil), "1")
+		w := httptest.NewRecorder()
+
+		h.RestoreTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK {
+			t.Fatalf("RestoreTaskHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// gRPC statuses are mapped to HTTP statuses
+	{
+		req := withID(httptest.NewRequest(http.MethodGet, "/tasks/404", nil), "404")
+		w := httptest.NewRecorder()
+
+		h.GetTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusNotFound {
+			t.Fatalf("GetTaskHandler: ожидался 404 для NotFound, получили %d", w.Result().StatusCode)
+		}
+
+		req = withID(httptest.NewRequest(http.MethodPatch, "/tasks/2/reopen", nil), "2")
+		w = httptest.NewRecorder()
+
+		h.ReopenTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusConflict {
+			t.Fatalf("ReopenTaskHandler: ожидался 409 для FailedPrecondition, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// The request context reaches the client
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		req := withID(httptest.NewRequest(http.MethodGet, "/tasks/1", nil).WithContext(ctx), "1")
+		w := httptest.NewRecorder()
+
+		h.GetTaskHandler(w, req)
+		cancel()
+
+		if todo.ctx == nil || todo.ctx.Err() != context.Canceled {
+			t.Fatalf("GetTaskHandler: ожидался контекст запроса, получили %v", todo.ctx)
+		}
+	}
+
+	// Errors are problem details without internal messages
+	{
+		req := withID(httptest.NewRequest(http.MethodGet, "/api/v1/todos/404", nil), "404")
+		req = req.WithContext(context.WithValue(req.Context(), middleware.RequestIDKey, "req-1"))
+		w := httptest.NewRecorder()
+
+		h.GetTaskHandler(w, req)
+
+		var body map[string]any
+		_ = json.NewDecoder(w.Body).Decode(&body)
+
+		if w.Result().Header.Get("Content-Type") != "application/problem+json" {
+			t.Fatalf("GetTaskHandler: ожидался application/problem+json, получили %q", w.Result().Header.Get("Content-Type"))
+		}
+
+		if body["type"] != "about:blank" || body["title"] != "Not Found" || body["status"] != float64(404) ||
+			body["detail"] != "not found" || body["instance"] != "/api/v1/todos/404" || body["request_id"] != "req-1" {
+			t.Fatalf("GetTaskHandler: ожидалась проблема без внутренних деталей, получили %v", body)
+		}
+	}
+
+	// ReopenTask
+	{
+		before := len(prod.messages)
+		req := withID(httptest.NewRequest(http.MethodPatch, "/tasks/1/reopen", nil), "1")
+		w := httptest.NewRecorder()
+
+		h.ReopenTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK {
+			t.Fatalf("ReopenTaskHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+
+		if len(prod.messages) != before+1 || !strings.Contains(prod.messages[before], "action=reopen_task") {
+			t.Fatalf("ReopenTaskHandler: ожидалось событие reopen_task, получили %v", prod.messages[before:])
+		}
+	}
+
+	// TransitionTask
+	{
+		b, _ := json.Marshal(map[string]string{"status": "in_progress"})
+		req := withID(httptest.NewRequest(http.MethodPatch, "/tasks/1/status", bytes.NewReader(b)), "1")
+		w := httptest.NewRecorder()
+
+		h.TransitionTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK {
+			t.Fatalf("TransitionTaskHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// TransitionTask with unknown status
+	{
+		b, _ := json.Marshal(map[string]string{"status": "blocked"})
+		req := withID(httptest.NewRequest(http.MethodPatch, "/tasks/1/status", bytes.NewReader(b)), "1")
+		w := httptest.NewRecorder()
+
+		h.TransitionTaskHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusBadRequest {
+			t.Fatalf("TransitionTaskHandler: ожидался 400, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// ListDueBetween
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks/due?from=2025-01-01T00:00:00Z&to=2025-02-01T00:00:00Z", nil)
+		w := httptest.NewRecorder()
+
+		h.ListDueBetweenHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK {
+			t.Fatalf("ListDueBetweenHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// ListDueBetween with invalid range
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks/due?from=tomorrow", nil)
+		w := httptest.NewRecorder()
+
+		h.ListDueBetweenHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusBadRequest {
+			t.Fatalf("ListDueBetweenHandler: ожидался 400, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// ListCompletedTasks
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks/completed", nil)
+		w := httptest.NewRecorder()
+
+		h.ListCompletedTasksHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK {
+			t.Fatalf("ListCompletedTasksHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// ListNotCompletedTasks
+	{
+		req := httptest.NewRequest(http.MethodGet, "/tasks/not-completed", nil)
+		w := httptest.NewRecorder()
+
+		h.ListNotCompletedTasksHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusOK {
+			t.Fatalf("ListNotCompletedTasksHandler: ожидался 200, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// CreateView
+	{
+		body := `{"owner":"alice","name":"This week","criteria":{"completed":false,"filter":"created>-7d"},"sort":"-created_at"}`
+		req := httptest.NewRequest(http.MethodPost, "/views", strings.NewReader(body))
+		w := httptest.NewRecorder()
+
+		h.CreateViewHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusCreated {
+			t.Fatalf("CreateViewHandler: ожидался 201, получили %d", w.Result().StatusCode)
+		}
+
+		c := views.created
+
+		if c.Owner != "alice" || c.Sort != "-created_at" || c.Criteria.Completed == nil || c.Criteria.Filter != "created>-7d" {
+			t.Fatalf("CreateViewHandler: представление сохранено неверно: %+v", c)
+		}
+	}
+
+	// CreateView with invalid fields
+	{
+		req := httptest.NewRequest(http.MethodPost, "/views", strings.NewReader(`{"name":"","sort":"owner"}`))
+		w := httptest.NewRecorder()
+
+		h.CreateViewHandler(w, req)
+
+		var body struct {
+			Errors []map[string]string `json:"errors"`
+		}
+		_ = json.NewDecoder(w.Body).Decode(&body)
+
+		if w.Result().StatusCode != http.StatusBadRequest || len(body.Errors) != 3 {
+			t.Fatalf("CreateViewHandler: ожидался 400 с тремя ошибками, получили %d %v", w.Result().StatusCode, body.Errors)
+		}
+	}
+
+	// CreateView with a taken name or an invalid filter
+	for body, want := range map[string]int{
+		`{"owner":"alice","name":"Taken"}`:                                http.StatusConflict,
+		`{"owner":"alice","name":"Typo","criteria":{"filter":"titel:x"}}`: http.StatusBadRequest,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/views", strings.NewReader(body))
+		w := httptest.NewRecorder()
+
+		h.CreateViewHandler(w, req)
+
+		if w.Result().StatusCode != want {
+			t.Fatalf("CreateViewHandler(%s): ожидался %d, получили %d", body, want, w.Result().StatusCode)
+		}
+	}
+
+	// ListViews without owner
+	{
+		req := httptest.NewRequest(http.MethodGet, "/views", nil)
+		w := httptest.NewRecorder()
+
+		h.ListViewsHandler(w, req)
+
+		if w.Result().StatusCode != http.StatusBadRequest {
+			t.Fatalf("ListViewsHandler: ожидался 400, получили %d", w.Result().StatusCode)
+		}
+	}
+
+	// GetView requires the owner and hides views of other owners
+	for target, want := range map[string]int{
+		"/views/1":             http.StatusBadRequest,
+		"/views/1?owner=bob":   http.StatusNotFound,
+		"/views/1?owner=alice": http.StatusOK,
+	} {
+		req := withParam(httptest.NewRequest(http.MethodGet, target, nil), "vid", "1")
+		w := httptest.NewRecorder()
+
+		h.GetViewHandler(w, req)
+
+		if w.Result().StatusCode != want {
+			t.Fatalf("GetViewHandler(%s): ожидался %d, получили %d", target, want, w.Result().StatusCode)
+		}
+	}
+
+	// ListViewTasks
+	{
+		req := withParam(httptest.NewRequest(http.MethodGet, "/views/1/todos?owner=alice&limit=20", nil), "vid", "1")
+		w := httptest.NewRecorder()
+
+		h.ListViewTasksHandler(w, req)
+
+		var page models.TaskPage
+		_ = json.NewDecoder(w.Body).Decode(&page)
+
+		if w.Result().StatusCode != http.StatusOK || len(page.Items) != 1 || page.NextCursor != "next" {
+			t.Fatalf("ListViewTasksHandler: ожидалась страница задач, получили %d %+v", w.Result().StatusCode, page)
 		}
 	}
 }
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"todo/api/internal/domain/models"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 500
+)
+
+// parsePage reads the ?limit= and ?cursor= query parameters of a paginated list.
+func parsePage(r *http.Request) (int32, string, error) {
+	query := r.URL.Query()
+	limit := defaultLimit
+
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+
+		if err != nil || n < 1 || n > maxLimit {
+			return 0, "", errors.New("invalid limit")
+		}
+
+		limit = n
+	}
+
+	return int32(limit), query.Get("cursor"), nil
+}
+
+func writePage(w http.ResponseWriter, page models.TaskPage) {
+	if page.Items == nil {
+		page.Items = []models.Task{}
+	}
+
+	_ = json.NewEncoder(w).Encode(page)
+}
+
+// writeTasks encodes a task list, with an empty list as [] rather than null.
+func writeTasks(w http.ResponseWriter, tasks []models.Task) {
+	if tasks == nil {
+		tasks = []models.Task{}
+	}
+
+	_ = json.NewEncoder(w).Encode(tasks)
+}
@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"todo/api/internal/domain/models"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const mergePatchType = "application/merge-patch+json"
+
+// patchFields maps the task keys a merge patch may set to their UpdateTask field mask paths.
+var patchFields = map[string]string{
+	"name":        "title",
+	"description": "description",
+	"priority":    "priority",
+	"due_at":      "due_at",
+	"recurrence":  "recurrence",
+	"project_id":  "project_id",
+}
+
+// readOnlyFields are task keys that appear in responses but can't be patched.
+var readOnlyFields = map[string]bool{
+	"id":                 true,
+	"completed":          true,
+	"status":             true,
+	"status_changed_at":  true,
+	"created_at":         true,
+	"completed_at":       true,
+	"overdue":            true,
+	"tags":               true,
+	"parent_id":          true,
+	"subtasks":           true,
+	"next_occurrence_at": true,
+	"deleted_at":         true,
+	"archived_at":        true,
+	"version":            true,
+}
+
+// PatchTaskHandler applies an RFC 7386 merge patch to a task. Only the keys present in the
+// patch are written; null clears a field back to its default. An empty patch changes nothing
+// and answers with the current task.
+func (h *Handlers) PatchTaskHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		mediaType, _, err := mime.ParseMediaType(ct)
+
+		if err != nil || (mediaType != mergePatchType && mediaType != "application/json") {
+			writeProblem(w, r, http.StatusUnsupportedMediaType, "unsupported content type")
+			return
+		}
+	}
+
+	var patch map[string]json.RawMessage
+
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil || patch == nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	task, fields, problems := parsePatch(patch)
+
+	if len(problems) > 0 {
+		writeValidationProblem(w, r, "invalid patch", problems)
+		return
+	}
+
+	version, ok := h.checkIfMatch(w, r, id)
+
+	if !ok {
+		return
+	}
+
+	if len(fields) == 0 {
+		task, err = h.todo.GetTask(r.Context(), id)
+
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		if version != 0 && version != task.Version {
+			writeError(w, r, models.ErrVersionMismatch)
+			return
+		}
+
+		writeTask(w, http.StatusOK, task)
+		return
+	}
+
+	task.Id, task.Version = id, version
+	task, err = h.todo.UpdateTask(r.Context(), task, fields)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=patch_task id=%d fields=%s",
+			time.Now().Format(time.RFC3339), id, strings.Join(fields, ",")),
+	)
+
+	writeTask(w, http.StatusOK, task)
+}
+
+// parsePatch decodes a merge patch into the task fields it sets and their field mask paths.
+// Every key that can't be decoded is collected so the client sees them all at once; the decoded
+// values are checked by db-service like a full task.
+func parsePatch(patch map[string]json.RawMessage) (models.Task, []string, []fieldError) {
+	var (
+		task   models.Task
+		fields []string
+		errs   []fieldError
+	)
+
+	add := func(field, message string) {
+		errs = append(errs, fieldError{field, message})
+	}
+
+	keys := make([]string, 0, len(patch))
+
+	for key := range patch {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		path, ok := patchFields[key]
+
+		if !ok {
+			if readOnlyFields[key] {
+				add(key, "is read-only")
+			} else {
+				add(key, "unknown field")
+			}
+
+			continue
+		}
+
+		raw := patch[key]
+		null := bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+		before := len(errs)
+
+		switch key {
+		case "name":
+			if !null && json.Unmarshal(raw, &task.Name) != nil {
+				add(key, "must be a string")
+			}
+		case "description":
+			if !null && json.Unmarshal(raw, &task.Description) != nil {
+				add(key, "must be a string")
+			}
+		case "priority":
+			task.Priority = models.PriorityNone
+
+			if !null && json.Unmarshal(raw, &task.Priority) != nil {
+				add(key, "must be a string")
+			}
+		case "due_at":
+			if !null && json.Unmarshal(raw, &task.DueAt) != nil {
+				add(key, "must be an RFC 3339 timestamp")
+			}
+		case "recurrence":
+			if !null && json.Unmarshal(raw, &task.Recurrence) != nil {
+				add(key, "must be a string")
+			}
+		case "project_id":
+			if !null && (json.Unmarshal(raw, &task.ProjectId) != nil || *task.ProjectId < 1) {
+				add(key, "must be a positive integer")
+			}
+		}
+
+		if len(errs) == before {
+			fields = append(fields, path)
+		}
+	}
+
+	return task, fields, errs
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const problemContentType = "application/problem+json"
+
+// Problem types the frontend can branch on. Errors without a more specific type use
+// "about:blank", whose title is the HTTP status text.
+const (
+	problemBlank             = "about:blank"
+	problemValidation        = "/problems/validation-error"
+	problemInvalidFilter     = "/problems/invalid-filter"
+	problemVersionMismatch   = "/problems/version-mismatch"
+	problemIdempotencyReused = "/problems/idempotency-key-reused"
+	problemViewExists        = "/problems/view-exists"
+)
+
+// problem is an RFC 7807 problem details body. Position and End are only set for a rejected
+// filter expression and point at the offending span, End exclusive.
+type problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	RequestID string       `json:"request_id,omitempty"`
+	Errors    []fieldError `json:"errors,omitempty"`
+	Position  *int         `json:"position,omitempty"`
+	End       *int         `json:"end,omitempty"`
+}
+
+// fieldError is one invalid request field. Field is the JSON or query parameter name, with
+// nested fields joined by dots.
+type fieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// writeProblem answers with a problem of the blank type.
+func writeProblem(w http.ResponseWriter, r *http.Request, status int, detail string) {
+	sendProblem(w, r, problem{Status: status, Detail: detail})
+}
+
+// writeValidationProblem answers 400 with every invalid field of the request.
+func writeValidationProblem(w http.ResponseWriter, r *http.Request, detail string, errs []fieldError) {
+	sendProblem(w, r, problem{
+		Type:   problemValidation,
+		Title:  "Invalid request",
+		Status: http.StatusBadRequest,
+		Detail: detail,
+		Errors: errs,
+	})
+}
+
+// sendProblem fills in the fields every problem shares and writes it.
+func sendProblem(w http.ResponseWriter, r *http.Request, p problem) {
+	if p.Type == "" {
+		p.Type = problemBlank
+	}
+
+	if p.Title == "" {
+		p.Title = http.StatusText(p.Status)
+	}
+
+	p.Instance = r.URL.Path
+	p.RequestID = middleware.GetReqID(r.Context())
+
+	w.Header().Set("Content-Type", problemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+
+	_ = json.NewEncoder(w).Encode(p)
+}
@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"todo/api/internal/domain/models"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *Handlers) CreateProjectHandler(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	if req.Name == "" {
+		writeProblem(w, r, http.StatusBadRequest, "invalid name")
+		return
+	}
+
+	project, err := h.projects.CreateProject(r.Context(), models.Project{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=create_project id=%d name=%s",
+			time.Now().Format(time.RFC3339), project.Id, project.Name),
+	)
+
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(project)
+}
+
+func (h *Handlers) GetProjectHandler(w http.ResponseWriter, r *http.Request) {
+	pidStr := chi.URLParam(r, "pid")
+	pid, err := strconv.ParseInt(pidStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid project id")
+		return
+	}
+
+	project, err := h.projects.GetProject(r.Context(), pid)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=get_project id=%d",
+			time.Now().Format(time.RFC3339), pid),
+	)
+
+	_ = json.NewEncoder(w).Encode(project)
+}
+
+func (h *Handlers) EditProjectHandler(w http.ResponseWriter, r *http.Request) {
+	pidStr := chi.URLParam(r, "pid")
+	pid, err := strconv.ParseInt(pidStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid project id")
+		return
+	}
+
+	var req struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	if req.Name == "" {
+		writeProblem(w, r, http.StatusBadRequest, "invalid name")
+		return
+	}
+
+	if err := h.projects.EditProject(r.Context(), models.Project{
+		Id:          pid,
+		Name:        req.Name,
+		Description: req.Description,
+	}); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=edit_project id=%d name=%s",
+			time.Now().Format(time.RFC3339), pid, req.Name),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) DeleteProjectHandler(w http.ResponseWriter, r *http.Request) {
+	pidStr := chi.URLParam(r, "pid")
+	pid, err := strconv.ParseInt(pidStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid project id")
+		return
+	}
+
+	mode := r.URL.Query().Get("mode")
+
+	if mode == "" {
+		mode = models.DeleteModeArchive
+	}
+
+	if mode != models.DeleteModeArchive && mode != models.DeleteModeCascade {
+		writeProblem(w, r, http.StatusBadRequest, "invalid mode")
+		return
+	}
+
+	if err := h.projects.DeleteProject(r.Context(), pid, mode == models.DeleteModeCascade); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=delete_project id=%d mode=%s",
+			time.Now().Format(time.RFC3339), pid, mode),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) ListProjectsHandler(w http.ResponseWriter, r *http.Request) {
+	includeArchived := r.URL.Query().Get("archived") == "true"
+
+	projects, err := h.projects.ListProjects(r.Context(), includeArchived)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=list_projects count=%d",
+			time.Now().Format(time.RFC3339), len(projects)),
+	)
+
+	_ = json.NewEncoder(w).Encode(projects)
+}
+
+func (h *Handlers) ListProjectTasksHandler(w http.ResponseWriter, r *http.Request) {
+	pidStr := chi.URLParam(r, "pid")
+	pid, err := strconv.ParseInt(pidStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid project id")
+		return
+	}
+
+	tasks, err := h.projects.ListProjectTasks(r.Context(), pid)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if tasks == nil {
+		tasks = []models.Task{}
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=list_project_tasks id=%d count=%d",
+			time.Now().Format(time.RFC3339), pid, len(tasks)),
+	)
+
+	writeTasks(w, tasks)
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"todo/api/internal/domain/models"
+	"unicode/utf8"
+)
+
+const maxFilterLength = 1024
+
+// sortFields lists the fields GET /todos can be sorted by.
+var sortFields = map[string]bool{
+	"created_at": true,
+	"title":      true,
+	"priority":   true,
+}
+
+// parseTaskQuery reads the filter, sort and page parameters of GET /todos. It reports every
+// invalid parameter rather than stopping at the first one.
+func parseTaskQuery(r *http.Request) (models.TaskQuery, []fieldError) {
+	query := r.URL.Query()
+
+	var (
+		taskQuery models.TaskQuery
+		problems  []fieldError
+		err       error
+	)
+
+	taskQuery.Limit, taskQuery.Cursor, err = parsePage(r)
+
+	if err != nil {
+		problems = append(problems, fieldError{"limit", "must be an integer between 1 and " + strconv.Itoa(maxLimit)})
+	}
+
+	taskQuery.Q = strings.TrimSpace(query.Get("q"))
+	taskQuery.Filter = query.Get("filter")
+
+	if utf8.RuneCountInString(taskQuery.Filter) > maxFilterLength {
+		problems = append(problems, fieldError{"filter", "must be at most " + strconv.Itoa(maxFilterLength) + " characters"})
+	}
+
+	if v := query.Get("completed"); v != "" {
+		completed, err := strconv.ParseBool(v)
+
+		if err != nil {
+			problems = append(problems, fieldError{"completed", "must be true or false"})
+		} else {
+			taskQuery.Completed = &completed
+		}
+	}
+
+	if v := query.Get("created_after"); v != "" {
+		if taskQuery.CreatedAfter, err = parseTime(v); err != nil {
+			problems = append(problems, fieldError{"created_after", "must be an RFC 3339 timestamp or a YYYY-MM-DD date"})
+		}
+	}
+
+	if v := query.Get("created_before"); v != "" {
+		if taskQuery.CreatedBefore, err = parseTime(v); err != nil {
+			problems = append(problems, fieldError{"created_before", "must be an RFC 3339 timestamp or a YYYY-MM-DD date"})
+		}
+	}
+
+	if !taskQuery.CreatedAfter.IsZero() && !taskQuery.CreatedBefore.IsZero() &&
+		!taskQuery.CreatedAfter.Before(taskQuery.CreatedBefore) {
+		problems = append(problems, fieldError{"created_after", "must be before created_before"})
+	}
+
+	if v := query.Get("sort"); v != "" {
+		sort, sortProblems := parseSort(v)
+		taskQuery.Sort = sort
+		problems = append(problems, sortProblems...)
+	}
+
+	return taskQuery, problems
+}
+
+// parseSort reads a comma-separated list of fields, each optionally prefixed with "-" for
+// descending order, e.g. "-created_at,title".
+func parseSort(v string) ([]models.SortKey, []fieldError) {
+	var (
+		sort     []models.SortKey
+		problems []fieldError
+	)
+
+	seen := make(map[string]bool)
+
+	for _, field := range strings.Split(v, ",") {
+		key := models.SortKey{Field: strings.TrimSpace(field)}
+
+		if strings.HasPrefix(key.Field, "-") {
+			key.Field, key.Desc = key.Field[1:], true
+		}
+
+		switch {
+		case !sortFields[key.Field]:
+			problems = append(problems, fieldError{"sort", fmt.Sprintf("unknown field %q", key.Field)})
+		case seen[key.Field]:
+			problems = append(problems, fieldError{"sort", fmt.Sprintf("duplicate field %q", key.Field)})
+		default:
+			seen[key.Field] = true
+			sort = append(sort, key)
+		}
+	}
+
+	return sort, problems
+}
+
+func parseTime(v string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.DateOnly, v)
+}
+
+// writeInvalidFilter reports a rejected filter expression with the span to underline.
+func writeInvalidFilter(w http.ResponseWriter, r *http.Request, err *models.FilterError) {
+	sendProblem(w, r, problem{
+		Type:     problemInvalidFilter,
+		Title:    "Invalid filter",
+		Status:   http.StatusBadRequest,
+		Detail:   err.Message,
+		Position: &err.Pos,
+		End:      &err.End,
+	})
+}
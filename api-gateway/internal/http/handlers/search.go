@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"todo/api/internal/domain/models"
+)
+
+const maxSearchLimit = 100
+
+// searchLanguages maps the accepted ?lang= values to the text search configurations tasks are indexed in.
+var searchLanguages = map[string]string{
+	"en":      "english",
+	"english": "english",
+	"ru":      "russian",
+	"russian": "russian",
+}
+
+// SearchTasksHandler runs a full-text search. Words in q must all match, "quoted words" match
+// as a phrase and a word ending in * matches by prefix.
+func (h *Handlers) SearchTasksHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	q := strings.TrimSpace(query.Get("q"))
+
+	if q == "" {
+		writeProblem(w, r, http.StatusBadRequest, "q is required")
+		return
+	}
+
+	language := ""
+
+	if v := query.Get("lang"); v != "" {
+		var ok bool
+
+		if language, ok = searchLanguages[strings.ToLower(v)]; !ok {
+			writeProblem(w, r, http.StatusBadRequest, "invalid lang")
+			return
+		}
+	}
+
+	var limit int32
+
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+
+		if err != nil || n < 1 || n > maxSearchLimit {
+			writeProblem(w, r, http.StatusBadRequest, "invalid limit")
+			return
+		}
+
+		limit = int32(n)
+	}
+
+	hits, err := h.todo.SearchTasks(r.Context(), q, language, limit)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if hits == nil {
+		hits = []models.SearchHit{}
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=search_tasks count=%d",
+			time.Now().Format(time.RFC3339), len(hits)),
+	)
+
+	_ = json.NewEncoder(w).Encode(hits)
+}
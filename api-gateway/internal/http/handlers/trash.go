@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *Handlers) ListTrashHandler(w http.ResponseWriter, r *http.Request) {
+	tasks, err := h.todo.ListTrash(r.Context())
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	writeTasks(w, tasks)
+}
+
+func (h *Handlers) RestoreTaskHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.todo.RestoreTask(r.Context(), id); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=restore_task id=%d",
+			time.Now().Format(time.RFC3339), id),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) PurgeTaskHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.todo.PurgeTask(r.Context(), id); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=purge_task id=%d",
+			time.Now().Format(time.RFC3339), id),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"todo/api/internal/domain/models"
+	"unicode/utf8"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type viewRequest struct {
+	Owner    string              `json:"owner"`
+	Name     string              `json:"name"`
+	Criteria models.ViewCriteria `json:"criteria"`
+	Sort     string              `json:"sort"`
+}
+
+func (h *Handlers) CreateViewHandler(w http.ResponseWriter, r *http.Request) {
+	var req viewRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	req.Owner = strings.TrimSpace(req.Owner)
+
+	problems := validateView(req)
+
+	if req.Owner == "" {
+		problems = append([]fieldError{{"owner", "is required"}}, problems...)
+	}
+
+	if len(problems) > 0 {
+		writeValidationProblem(w, r, "invalid view", problems)
+		return
+	}
+
+	view, err := h.views.CreateView(r.Context(), models.View{
+		Owner:    req.Owner,
+		Name:     req.Name,
+		Criteria: req.Criteria,
+		Sort:     req.Sort,
+	})
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=create_view id=%d owner=%s",
+			time.Now().Format(time.RFC3339), view.Id, view.Owner),
+	)
+
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(view)
+}
+
+func (h *Handlers) GetViewHandler(w http.ResponseWriter, r *http.Request) {
+	vidStr := chi.URLParam(r, "vid")
+	vid, err := strconv.ParseInt(vidStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid view id")
+		return
+	}
+
+	owner, ok := viewOwner(w, r)
+
+	if !ok {
+		return
+	}
+
+	view, err := h.views.GetView(r.Context(), vid, owner)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(view)
+}
+
+func (h *Handlers) EditViewHandler(w http.ResponseWriter, r *http.Request) {
+	vidStr := chi.URLParam(r, "vid")
+	vid, err := strconv.ParseInt(vidStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid view id")
+		return
+	}
+
+	owner, ok := viewOwner(w, r)
+
+	if !ok {
+		return
+	}
+
+	var req viewRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	if problems := validateView(req); len(problems) > 0 {
+		writeValidationProblem(w, r, "invalid view", problems)
+		return
+	}
+
+	if err := h.views.EditView(r.Context(), models.View{
+		Id:       vid,
+		Owner:    owner,
+		Name:     req.Name,
+		Criteria: req.Criteria,
+		Sort:     req.Sort,
+	}); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=edit_view id=%d",
+			time.Now().Format(time.RFC3339), vid),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) DeleteViewHandler(w http.ResponseWriter, r *http.Request) {
+	vidStr := chi.URLParam(r, "vid")
+	vid, err := strconv.ParseInt(vidStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid view id")
+		return
+	}
+
+	owner, ok := viewOwner(w, r)
+
+	if !ok {
+		return
+	}
+
+	if err := h.views.DeleteView(r.Context(), vid, owner); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=delete_view id=%d",
+			time.Now().Format(time.RFC3339), vid),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handlers) ListViewsHandler(w http.ResponseWriter, r *http.Request) {
+	owner, ok := viewOwner(w, r)
+
+	if !ok {
+		return
+	}
+
+	views, err := h.views.ListViews(r.Context(), owner)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if views == nil {
+		views = []models.View{}
+	}
+
+	_ = json.NewEncoder(w).Encode(views)
+}
+
+// ListViewTasksHandler runs a saved view and returns one page of its tasks.
+func (h *Handlers) ListViewTasksHandler(w http.ResponseWriter, r *http.Request) {
+	vidStr := chi.URLParam(r, "vid")
+	vid, err := strconv.ParseInt(vidStr, 10, 64)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, "invalid view id")
+		return
+	}
+
+	owner, ok := viewOwner(w, r)
+
+	if !ok {
+		return
+	}
+
+	limit, cursor, err := parsePage(r)
+
+	if err != nil {
+		writeProblem(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.views.ListViewTasks(r.Context(), vid, owner, limit, cursor)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	_ = h.producer.Publish(
+		fmt.Sprintf("time=%s action=list_view_tasks id=%d count=%d",
+			time.Now().Format(time.RFC3339), vid, len(page.Items)),
+	)
+
+	writePage(w, page)
+}
+
+// viewOwner reads the required ?owner= parameter. Views are only reachable through their
+// owner, so a view of someone else is answered with 404.
+func viewOwner(w http.ResponseWriter, r *http.Request) (string, bool) {
+	owner := strings.TrimSpace(r.URL.Query().Get("owner"))
+
+	if owner == "" {
+		writeProblem(w, r, http.StatusBadRequest, "owner is required")
+		return "", false
+	}
+
+	return owner, true
+}
+
+// validateView checks the editable part of a view with the same rules as the GET /todos
+// parameters. The filter expression itself is checked by db-service.
+func validateView(req viewRequest) []fieldError {
+	var problems []fieldError
+
+	if strings.TrimSpace(req.Name) == "" {
+		problems = append(problems, fieldError{"name", "is required"})
+	}
+
+	c := req.Criteria
+
+	if c.CreatedAfter != nil && c.CreatedBefore != nil && !c.CreatedAfter.Before(*c.CreatedBefore) {
+		problems = append(problems, fieldError{"criteria.created_after", "must be before created_before"})
+	}
+
+	if utf8.RuneCountInString(c.Filter) > maxFilterLength {
+		problems = append(problems, fieldError{"criteria.filter", "must be at most " + strconv.Itoa(maxFilterLength) + " characters"})
+	}
+
+	if req.Sort != "" {
+		_, sortProblems := parseSort(req.Sort)
+		problems = append(problems, sortProblems...)
+	}
+
+	return problems
+}
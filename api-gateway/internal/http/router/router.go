@@ -5,6 +5,7 @@ import (
 	"todo/api/internal/http/handlers"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 )
 
@@ -21,25 +22,80 @@ func New(handlers *handlers.Handlers) *Router {
 func (r *Router) InitRouter() http.Handler {
 	router := chi.NewRouter()
 
+	router.Use(middleware.RequestID)
 	router.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "If-Match", "If-None-Match", "Idempotency-Key"},
+		ExposedHeaders:   []string{"ETag", "Location", "Idempotent-Replayed"},
 		AllowCredentials: true,
 	}))
 
 	router.Route("/api/v1/todos", func(ch chi.Router) {
-		ch.Get("/", r.handlers.ListTasksHandler)   // GET /api/v1/todos
+		ch.Get("/", r.handlers.ListTasksHandler)   // GET /api/v1/todos?filter=&q=&completed=&created_after=&created_before=&sort=-created_at,title&limit=&cursor=, ?order=priority, ?tag=a&tag=b&match=all|any
 		ch.Post("/", r.handlers.CreateTaskHandler) // POST /api/v1/todos
 
-		ch.Get("/{id}", r.handlers.GetTaskHandler)                 // GET /api/v1/todos/{id}
-		ch.Put("/{id}", r.handlers.EditTaskHandler)                // PUT /api/v1/todos/{id}
-		ch.Delete("/{id}", r.handlers.DeleteTaskHandler)           // DELETE /api/v1/todos/{id}
-		ch.Patch("/{id}/complete", r.handlers.CompleteTaskHandler) // PATCH /api/v1/todos/{id}/complete
+		ch.Get("/{id}", r.handlers.GetTaskHandler)                   // GET /api/v1/todos/{id}?expand=subtasks
+		ch.Put("/{id}", r.handlers.EditTaskHandler)                  // PUT /api/v1/todos/{id}
+		ch.Patch("/{id}", r.handlers.PatchTaskHandler)               // PATCH /api/v1/todos/{id}
+		ch.Delete("/{id}", r.handlers.DeleteTaskHandler)             // DELETE /api/v1/todos/{id}
+		ch.Patch("/{id}/complete", r.handlers.CompleteTaskHandler)   // PATCH /api/v1/todos/{id}/complete?cascade=true
+		ch.Patch("/{id}/status", r.handlers.TransitionTaskHandler)   // PATCH /api/v1/todos/{id}/status
+		ch.Patch("/{id}/reopen", r.handlers.ReopenTaskHandler)       // PATCH /api/v1/todos/{id}/reopen
+		ch.Patch("/{id}/archive", r.handlers.ArchiveTaskHandler)     // PATCH /api/v1/todos/{id}/archive
+		ch.Patch("/{id}/unarchive", r.handlers.UnarchiveTaskHandler) // PATCH /api/v1/todos/{id}/unarchive
+
+		ch.Get("/{id}/subtasks", r.handlers.ListSubtasksHandler) // GET /api/v1/todos/{id}/subtasks?expand=subtasks
+		ch.Patch("/{id}/move", r.handlers.MoveTaskHandler)       // PATCH /api/v1/todos/{id}/move
+
+		ch.Get("/{id}/blockers", r.handlers.ListBlockersHandler)                    // GET /api/v1/todos/{id}/blockers
+		ch.Post("/{id}/blockers", r.handlers.AddDependencyHandler)                  // POST /api/v1/todos/{id}/blockers
+		ch.Delete("/{id}/blockers/{blockerId}", r.handlers.RemoveDependencyHandler) // DELETE /api/v1/todos/{id}/blockers/{blockerId}
+
+		ch.Post("/{id}/tags", r.handlers.AttachTagHandler)         // POST /api/v1/todos/{id}/tags
+		ch.Delete("/{id}/tags/{tag}", r.handlers.DetachTagHandler) // DELETE /api/v1/todos/{id}/tags/{tag}
 
 		ch.Get("/completed", r.handlers.ListCompletedTasksHandler)  // GET /api/v1/todos/completed
 		ch.Get("/pending", r.handlers.ListNotCompletedTasksHandler) // GET /api/v1/todos/pending
+		ch.Get("/overdue", r.handlers.ListOverdueTasksHandler)      // GET /api/v1/todos/overdue
+		ch.Get("/due", r.handlers.ListDueBetweenHandler)            // GET /api/v1/todos/due?from=&to=
+		ch.Get("/ready", r.handlers.ListReadyTasksHandler)          // GET /api/v1/todos/ready
+		ch.Get("/archived", r.handlers.ListArchivedTasksHandler)    // GET /api/v1/todos/archived
+		ch.Post("/archive", r.handlers.ArchiveCompletedHandler)     // POST /api/v1/todos/archive?before=
+		ch.Get("/search", r.handlers.SearchTasksHandler)            // GET /api/v1/todos/search?q=&lang=english|russian&limit=
+	})
+
+	router.Route("/api/v1/projects", func(ch chi.Router) {
+		ch.Get("/", r.handlers.ListProjectsHandler)   // GET /api/v1/projects?archived=true
+		ch.Post("/", r.handlers.CreateProjectHandler) // POST /api/v1/projects
+
+		ch.Get("/{pid}", r.handlers.GetProjectHandler)       // GET /api/v1/projects/{pid}
+		ch.Put("/{pid}", r.handlers.EditProjectHandler)      // PUT /api/v1/projects/{pid}
+		ch.Delete("/{pid}", r.handlers.DeleteProjectHandler) // DELETE /api/v1/projects/{pid}?mode=archive|cascade
+
+		ch.Get("/{pid}/todos", r.handlers.ListProjectTasksHandler) // GET /api/v1/projects/{pid}/todos
+		ch.Post("/{pid}/todos", r.handlers.CreateTaskHandler)      // POST /api/v1/projects/{pid}/todos
 	})
 
+	router.Route("/api/v1/views", func(ch chi.Router) {
+		ch.Get("/", r.handlers.ListViewsHandler)   // GET /api/v1/views?owner=
+		ch.Post("/", r.handlers.CreateViewHandler) // POST /api/v1/views
+
+		ch.Get("/{vid}", r.handlers.GetViewHandler)       // GET /api/v1/views/{vid}?owner=
+		ch.Put("/{vid}", r.handlers.EditViewHandler)      // PUT /api/v1/views/{vid}?owner=
+		ch.Delete("/{vid}", r.handlers.DeleteViewHandler) // DELETE /api/v1/views/{vid}?owner=
+
+		ch.Get("/{vid}/todos", r.handlers.ListViewTasksHandler) // GET /api/v1/views/{vid}/todos?owner=&limit=&cursor=
+	})
+
+	router.Route("/api/v1/trash", func(ch chi.Router) {
+		ch.Get("/", r.handlers.ListTrashHandler) // GET /api/v1/trash
+
+		ch.Post("/{id}/restore", r.handlers.RestoreTaskHandler) // POST /api/v1/trash/{id}/restore
+		ch.Delete("/{id}", r.handlers.PurgeTaskHandler)         // DELETE /api/v1/trash/{id}
+	})
+
+	router.Get("/api/v1/tags", r.handlers.ListTagsHandler) // GET /api/v1/tags
+
 	return router
 }
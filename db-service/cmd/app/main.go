@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 	"todo/db/internal/app"
 	"todo/db/internal/config"
 )
@@ -26,12 +27,22 @@ func main() {
 		cfg.Postgres.DSN,
 		cfg.Redis.DSN,
 		cfg.Redis.TTL,
+		cfg.Trash.Retention,
+		cfg.Trash.PurgeInterval,
+		time.Duration(cfg.Archive.AfterDays)*24*time.Hour,
+		cfg.Archive.Interval,
 	)
 
 	go func() {
 		application.Server.MustRun()
 	}()
 
+	go application.Purger.Run()
+
+	if application.Archiver != nil {
+		go application.Archiver.Run()
+	}
+
 	log.Info("zaebis rabotaet")
 
 	stop := make(chan os.Signal, 1)
@@ -39,6 +50,11 @@ func main() {
 
 	<-stop
 
+	if application.Archiver != nil {
+		application.Archiver.Stop()
+	}
+
+	application.Purger.Stop()
 	application.Server.Stop()
 
 	log.Info("Gracefully stopped")
@@ -4,6 +4,7 @@ import (
 	"log/slog"
 	"time"
 	"todo/db/internal/grpc/server"
+	"todo/db/internal/jobs"
 	"todo/db/internal/lib/sl"
 	"todo/db/internal/service"
 	"todo/db/internal/storage/postgres"
@@ -12,6 +13,9 @@ import (
 
 type App struct {
 	Server *server.Server
+	Purger *jobs.Purger
+	// Archiver is nil when auto-archiving is turned off.
+	Archiver *jobs.Archiver
 }
 
 func New(
@@ -20,6 +24,10 @@ func New(
 	postgresDsn string,
 	redisDsn string,
 	cacheTTL time.Duration,
+	trashRetention time.Duration,
+	purgeInterval time.Duration,
+	archiveAfter time.Duration,
+	archiveInterval time.Duration,
 ) *App {
 	pgStorage, err := postgres.New(postgresDsn)
 
@@ -36,10 +44,22 @@ func New(
 	}
 
 	taskService := service.New(log, pgStorage, redisCache)
+	projectService := service.NewProjectService(log, pgStorage, redisCache)
+	viewService := service.NewViewService(log, pgStorage, pgStorage)
 
-	grpcServer := server.New(log, taskService, grpcPort)
+	grpcServer := server.New(log, taskService, projectService, viewService, grpcPort)
+
+	purger := jobs.NewPurger(log, taskService, trashRetention, purgeInterval)
+
+	var archiver *jobs.Archiver
+
+	if archiveAfter > 0 {
+		archiver = jobs.NewArchiver(log, taskService, archiveAfter, archiveInterval)
+	}
 
 	return &App{
-		Server: grpcServer,
+		Server:   grpcServer,
+		Purger:   purger,
+		Archiver: archiver,
 	}
 }
@@ -13,6 +13,8 @@ type Config struct {
 	GRPC     GRPCConfig     `yaml:"grpc"`
 	Postgres PostgresConfig `yaml:"postgres"`
 	Redis    RedisConfig    `yaml:"redis"`
+	Trash    TrashConfig    `yaml:"trash"`
+	Archive  ArchiveConfig  `yaml:"archive"`
 }
 
 type GRPCConfig struct {
@@ -29,6 +31,18 @@ type RedisConfig struct {
 	TTL time.Duration `yaml:"ttl" env-default:"1h"`
 }
 
+type TrashConfig struct {
+	Retention     time.Duration `yaml:"retention" env-default:"720h"`
+	PurgeInterval time.Duration `yaml:"purge_interval" env-default:"1h"`
+}
+
+// ArchiveConfig controls the auto-archive policy. Tasks completed more than AfterDays days ago
+// are archived on every Interval; zero AfterDays turns the policy off.
+type ArchiveConfig struct {
+	AfterDays int           `yaml:"after_days" env-default:"30"`
+	Interval  time.Duration `yaml:"interval" env-default:"1h"`
+}
+
 func MustLoad() *Config {
 	configPath := fetchConfigPath()
 
@@ -46,6 +60,20 @@ func MustLoad() *Config {
 		panic("error to the read config file")
 	}
 
+	// time.NewTicker panics on a non-positive interval, so the jobs' intervals are checked here.
+	if cfg.Trash.PurgeInterval <= 0 {
+		panic("trash.purge_interval must be positive")
+	}
+
+	if cfg.Archive.AfterDays > 0 && cfg.Archive.Interval <= 0 {
+		panic("archive.interval must be positive")
+	}
+
+	// A non-positive retention would purge tasks the moment they reach the trash.
+	if cfg.Trash.Retention <= 0 {
+		panic("trash.retention must be positive")
+	}
+
 	return &cfg
 }
 
@@ -1,14 +1,142 @@
 package models
 
 import (
+	"strings"
+	"time"
+	"todo/db/internal/lib/filter"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	StatusTodo       = "todo"
+	StatusInProgress = "in_progress"
+	StatusReview     = "review"
+	StatusDone       = "done"
+	StatusCancelled  = "cancelled"
+)
+
+// PriorityUnset marks a task priority that wasn't sent. Update keeps the stored priority for it.
+const PriorityUnset int32 = -1
+
 type Task struct {
+	ID              int64
+	Title           string
+	Description     string
+	Completed       bool
+	Status          string
+	StatusChangedAt *timestamppb.Timestamp
+	Priority        int32
+	CreatedAt       *timestamppb.Timestamp
+	CompletedAt     *timestamppb.Timestamp
+	DueAt           *timestamppb.Timestamp
+	Tags            []string
+	ParentID        int64
+	Recurrence      string
+	ProjectID       int64
+	DeletedAt       *timestamppb.Timestamp
+	ArchivedAt      *timestamppb.Timestamp
+	Version         int64
+}
+
+// Cursor points at the last task of a page; the next page starts right after it.
+// Values holds the task's sort key values, one per key of the page's sort order.
+type Cursor struct {
+	Values []string
+	ID     int64
+}
+
+// SortKey orders a task list by one field.
+type SortKey struct {
+	Field string
+	Desc  bool
+}
+
+// DefaultSort is the order of a page that doesn't set one.
+var DefaultSort = []SortKey{{Field: "created_at"}}
+
+// Page selects Size tasks in Sort order (DefaultSort when empty) with id as the final
+// tie-breaker, starting after the cursor if one is set.
+type Page struct {
+	Size  int
+	After *Cursor
+	Sort  []SortKey
+}
+
+// TaskFilter narrows a task list. Zero fields don't filter; Expr is Expression once parsed.
+type TaskFilter struct {
+	Query         string
+	Completed     *bool
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Expression    string
+	Expr          filter.Expr
+}
+
+// SavedView is a named task list query kept for its owner. Filter.Expr is not stored.
+type SavedView struct {
+	ID        int64
+	Owner     string
+	Name      string
+	Filter    TaskFilter
+	Sort      []SortKey
+	CreatedAt *timestamppb.Timestamp
+}
+
+// SortSpec renders a sort order the way the API spells it, e.g. "-priority,title".
+func SortSpec(sort []SortKey) string {
+	fields := make([]string, 0, len(sort))
+
+	for _, key := range sort {
+		if key.Desc {
+			fields = append(fields, "-"+key.Field)
+		} else {
+			fields = append(fields, key.Field)
+		}
+	}
+
+	return strings.Join(fields, ",")
+}
+
+// ParseSortSpec reverses SortSpec. It doesn't check the fields.
+func ParseSortSpec(spec string) []SortKey {
+	if spec == "" {
+		return nil
+	}
+
+	var sort []SortKey
+
+	for _, field := range strings.Split(spec, ",") {
+		key := SortKey{Field: field}
+
+		if strings.HasPrefix(field, "-") {
+			key.Field, key.Desc = field[1:], true
+		}
+
+		sort = append(sort, key)
+	}
+
+	return sort
+}
+
+type Project struct {
 	ID          int64
-	Title       string
+	Name        string
 	Description string
-	Completed   bool
 	CreatedAt   *timestamppb.Timestamp
-	CompletedAt *timestamppb.Timestamp
+	ArchivedAt  *timestamppb.Timestamp
+}
+
+// SearchHit is a task matched by a full-text search, with its rank and a highlighted
+// snippet of its description, or of its title when only the title matches. The snippet is
+// HTML-escaped apart from the <b></b> around the matches.
+type SearchHit struct {
+	Task    Task
+	Rank    float64
+	Snippet string
+}
+
+type Tag struct {
+	Name  string
+	Count int64
 }
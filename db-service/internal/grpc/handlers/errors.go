@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"errors"
+	"todo/db/internal/service"
+	"todo/db/internal/storage"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusError converts an error from the service layer into a gRPC status, so the gateway can
+// tell a missing task from a broken rule or a failure. Unknown errors are Internal. A reused
+// idempotency key shares AlreadyExists with other conflicts, so it also carries an ErrorInfo
+// detail the gateway can match on.
+func statusError(err error) error {
+	st := status.New(errorCode(err), err.Error())
+
+	if errors.Is(err, service.ErrIdempotencyMismatch) {
+		if withInfo, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+			Reason: "IDEMPOTENCY_KEY_REUSED",
+			Domain: "todo.db",
+		}); detailErr == nil {
+			st = withInfo
+		}
+	}
+
+	return st.Err()
+}
+
+func errorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, storage.ErrNotFound),
+		errors.Is(err, storage.ErrReference),
+		errors.Is(err, service.ErrProjectNotFound):
+		return codes.NotFound
+	case errors.Is(err, storage.ErrConflict),
+		errors.Is(err, service.ErrViewExists),
+		errors.Is(err, service.ErrIdempotencyMismatch):
+		return codes.AlreadyExists
+	case errors.Is(err, service.ErrVersionMismatch):
+		return codes.Aborted
+	case errors.Is(err, service.ErrInvalidParent):
+		return codes.InvalidArgument
+	case errors.Is(err, service.ErrOpenSubtasks),
+		errors.Is(err, service.ErrBlocked),
+		errors.Is(err, service.ErrTransition),
+		errors.Is(err, service.ErrParentDeleted),
+		errors.Is(err, service.ErrTaskOpen),
+		errors.Is(err, service.ErrCycle):
+		return codes.FailedPrecondition
+	}
+
+	return codes.Internal
+}
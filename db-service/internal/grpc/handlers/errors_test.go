@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"todo/db/internal/service"
+	"todo/db/internal/storage"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{fmt.Errorf("service.GetTask: %w", storage.ErrNotFound), codes.NotFound},
+		{fmt.Errorf("service.EditTask: %w", service.ErrProjectNotFound), codes.NotFound},
+		{fmt.Errorf("service.CreateTask: %w", storage.ErrReference), codes.NotFound},
+		{fmt.Errorf("service.CreateProject: %w", storage.ErrConflict), codes.AlreadyExists},
+		{fmt.Errorf("service.CreateView: %w", service.ErrViewExists), codes.AlreadyExists},
+		{fmt.Errorf("service.EditTask: %w", service.ErrVersionMismatch), codes.Aborted},
+		{fmt.Errorf("service.MoveTask: %w", service.ErrInvalidParent), codes.InvalidArgument},
+		{fmt.Errorf("service.CompleteTask: %w", service.ErrOpenSubtasks), codes.FailedPrecondition},
+		{fmt.Errorf("service.AddDependency: %w", service.ErrCycle), codes.FailedPrecondition},
+		{errors.New("postgres: internal error"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		if got := errorCode(tt.err); got != tt.want {
+			t.Errorf("errorCode(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestStatusErrorReason(t *testing.T) {
+	reason := func(err error) string {
+		for _, detail := range status.Convert(err).Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok {
+				return info.GetReason()
+			}
+		}
+
+		return ""
+	}
+
+	if got := reason(statusError(fmt.Errorf("service.CreateTask: %w", service.ErrIdempotencyMismatch))); got != "IDEMPOTENCY_KEY_REUSED" {
+		t.Errorf("statusError(ErrIdempotencyMismatch): reason = %q, want IDEMPOTENCY_KEY_REUSED", got)
+	}
+
+	if got := reason(statusError(fmt.Errorf("service.CreateProject: %w", storage.ErrConflict))); got != "" {
+		t.Errorf("statusError(ErrConflict): reason = %q, want none", got)
+	}
+}
@@ -2,7 +2,14 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
 	"todo/db/internal/domain/models"
+	"todo/db/internal/lib/rrule"
+	"todo/db/internal/lib/validate"
+	"todo/db/internal/service"
 	dbpb "todo/proto/db/gen"
 
 	"google.golang.org/grpc"
@@ -11,16 +18,47 @@ import (
 )
 
 type DB interface {
-	CreateTask(ctx context.Context, title, description string, completed bool) error
+	CreateTask(ctx context.Context, task models.Task, key string) (models.Task, bool, error)
 	GetTask(ctx context.Context, id int64) (models.Task, error)
-	EditTask(ctx context.Context, id int64, title, description string) error
-	DeleteTask(ctx context.Context, id int64) error
-	CompleteTask(ctx context.Context, id int64) error
-	ListTasks(ctx context.Context) ([]models.Task, error)
-	ListCompletedTasks(ctx context.Context) ([]models.Task, error)
-	ListNotCompletedTasks(ctx context.Context) ([]models.Task, error)
+	EditTask(ctx context.Context, task models.Task) (models.Task, error)
+	UpdateTask(ctx context.Context, task models.Task, fields []string) (models.Task, error)
+	DeleteTask(ctx context.Context, id, version int64) error
+	CompleteTask(ctx context.Context, id int64, cascade bool) (models.Task, error)
+	ListTasks(ctx context.Context, filter models.TaskFilter, page models.Page) ([]models.Task, *models.Cursor, error)
+	ListCompletedTasks(ctx context.Context, page models.Page) ([]models.Task, *models.Cursor, error)
+	ListNotCompletedTasks(ctx context.Context, page models.Page) ([]models.Task, *models.Cursor, error)
+	ListTasksByPriority(ctx context.Context) ([]models.Task, error)
+	ListOverdueTasks(ctx context.Context) ([]models.Task, error)
+	ListDueBetween(ctx context.Context, from, to time.Time) ([]models.Task, error)
+	ListTasksByTags(ctx context.Context, tags []string, matchAll bool) ([]models.Task, error)
+	AttachTag(ctx context.Context, taskID int64, tag string) error
+	DetachTag(ctx context.Context, taskID int64, tag string) error
+	ListTags(ctx context.Context) ([]models.Tag, error)
+	ListSubtasks(ctx context.Context, id int64, recursive bool) ([]models.Task, error)
+	MoveTask(ctx context.Context, id, parentID int64) error
+	AddDependency(ctx context.Context, taskID, blockedByID int64) error
+	RemoveDependency(ctx context.Context, taskID, blockedByID int64) error
+	ListBlockers(ctx context.Context, id int64) ([]models.Task, error)
+	ListReadyTasks(ctx context.Context) ([]models.Task, error)
+	TransitionTask(ctx context.Context, id int64, status string) error
+	ReopenTask(ctx context.Context, id int64) error
+	ListTrash(ctx context.Context) ([]models.Task, error)
+	RestoreTask(ctx context.Context, id int64) error
+	PurgeTask(ctx context.Context, id int64) error
+	ArchiveTask(ctx context.Context, id int64) error
+	UnarchiveTask(ctx context.Context, id int64) error
+	ArchiveCompletedBefore(ctx context.Context, before time.Time) (int64, error)
+	ListArchivedTasks(ctx context.Context) ([]models.Task, error)
+	SearchTasks(ctx context.Context, text, language string, limit int) ([]models.SearchHit, error)
 }
 
+const (
+	statusPrefix      = "TASK_STATUS_"
+	maxIdempotencyKey = 255
+)
+
+var tagPattern = regexp.MustCompile(`^[\p{L}\p{N}_-]{1,64}$`)
+
 type ServerApi struct {
 	dbpb.UnimplementedTaskServiceServer
 	db DB
@@ -30,22 +68,39 @@ func Register(gRPCserver *grpc.Server, db DB) {
 	dbpb.RegisterTaskServiceServer(gRPCserver, &ServerApi{db: db})
 }
 
-func (s *ServerApi) CreateTask(ctx context.Context, in *dbpb.TaskRequest) (*dbpb.TaskResponse, error) {
-	if in.Title == "" || in.Description == "" {
-		return nil, status.Error(codes.InvalidArgument, "invalid arguments")
+func (s *ServerApi) CreateTask(ctx context.Context, in *dbpb.TaskRequest) (*dbpb.CreateTaskResponse, error) {
+	task := models.Task{
+		Title:       in.GetTitle(),
+		Description: in.GetDescription(),
+		Completed:   in.GetCompleted(),
+		Priority:    int32(in.GetPriority()),
+		DueAt:       in.GetDueAt(),
+		ParentID:    in.GetParentId(),
+		Recurrence:  in.GetRecurrence(),
+		ProjectID:   in.GetProjectId(),
 	}
 
-	if err := s.db.CreateTask(ctx,
-		in.GetTitle(),
-		in.GetDescription(),
-		in.GetCompleted(),
-	); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	var v validate.Validator
+
+	validateTask(&v, &task, nil)
+	validID(&v, "parent_id", in.ParentId)
+	validID(&v, "project_id", in.ProjectId)
+	v.Check("idempotency_key", len(in.GetIdempotencyKey()) <= maxIdempotencyKey,
+		fmt.Sprintf("must be at most %d bytes", maxIdempotencyKey))
+
+	if !v.Valid() {
+		return nil, invalidArgument(v.Violations())
 	}
 
-	return &dbpb.TaskResponse{
-		Status:  codes.OK.String(),
-		Message: "created",
+	data, replayed, err := s.db.CreateTask(ctx, task, in.GetIdempotencyKey())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.CreateTaskResponse{
+		Task:     toTaskItem(data),
+		Replayed: replayed,
 	}, nil
 }
 
@@ -57,34 +112,63 @@ func (s *ServerApi) GetTask(ctx context.Context, in *dbpb.TaskId) (*dbpb.TaskIte
 	data, err := s.db.GetTask(ctx, in.GetId())
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskItemResponse{
+		Task: toTaskItem(data),
+	}, nil
+}
+
+// EditTask overwrites a task. Every field but the title keeps its stored value when it is
+// unset or empty; UpdateTask is the way to clear one.
+func (s *ServerApi) EditTask(ctx context.Context, in *dbpb.EditTaskRequest) (*dbpb.TaskItemResponse, error) {
+	if in.Id < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	task := models.Task{
+		ID:          in.GetId(),
+		Title:       in.GetTitle(),
+		Description: in.GetDescription(),
+		Priority:    models.PriorityUnset,
+		DueAt:       in.GetDueAt(),
+		Recurrence:  in.GetRecurrence(),
+		ProjectID:   in.GetProjectId(),
+		Version:     in.GetVersion(),
+	}
+
+	if in.Priority != nil {
+		task.Priority = int32(in.GetPriority())
 	}
 
-	task := &dbpb.TaskItem{
-		Id:          data.ID,
-		Title:       data.Title,
-		Description: data.Description,
-		Completed:   data.Completed,
-		CreatedAt:   data.CreatedAt,
-		CompletedAt: data.CompletedAt,
+	var v validate.Validator
+
+	validateTask(&v, &task, nil)
+	validID(&v, "project_id", in.ProjectId)
+
+	if !v.Valid() {
+		return nil, invalidArgument(v.Violations())
+	}
+
+	data, err := s.db.EditTask(ctx, task)
+
+	if err != nil {
+		return nil, statusError(err)
 	}
 
 	return &dbpb.TaskItemResponse{
-		Task: task,
+		Task: toTaskItem(data),
 	}, nil
 }
 
-func (s *ServerApi) EditTask(ctx context.Context, in *dbpb.EditTaskRequest) (*dbpb.TaskResponse, error) {
-	if in.Id < 0 || in.Title == "" || in.Description == "" {
+func (s *ServerApi) DeleteTask(ctx context.Context, in *dbpb.DeleteTaskRequest) (*dbpb.TaskResponse, error) {
+	if in.Id < 0 || in.Version < 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid arguments")
 	}
 
-	if err := s.db.EditTask(ctx,
-		in.GetId(),
-		in.GetTitle(),
-		in.GetDescription(),
-	); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	if err := s.db.DeleteTask(ctx, in.GetId(), in.GetVersion()); err != nil {
+		return nil, statusError(err)
 	}
 
 	return &dbpb.TaskResponse{
@@ -93,13 +177,13 @@ func (s *ServerApi) EditTask(ctx context.Context, in *dbpb.EditTaskRequest) (*db
 	}, nil
 }
 
-func (s *ServerApi) DeleteTask(ctx context.Context, in *dbpb.TaskId) (*dbpb.TaskResponse, error) {
-	if in.Id < 0 {
+func (s *ServerApi) ArchiveTask(ctx context.Context, in *dbpb.TaskId) (*dbpb.TaskResponse, error) {
+	if in.Id < 1 {
 		return nil, status.Error(codes.InvalidArgument, "invalid id")
 	}
 
-	if err := s.db.DeleteTask(ctx, in.GetId()); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	if err := s.db.ArchiveTask(ctx, in.GetId()); err != nil {
+		return nil, statusError(err)
 	}
 
 	return &dbpb.TaskResponse{
@@ -108,13 +192,135 @@ func (s *ServerApi) DeleteTask(ctx context.Context, in *dbpb.TaskId) (*dbpb.Task
 	}, nil
 }
 
-func (s *ServerApi) CompleteTask(ctx context.Context, in *dbpb.TaskId) (*dbpb.TaskResponse, error) {
+func (s *ServerApi) UnarchiveTask(ctx context.Context, in *dbpb.TaskId) (*dbpb.TaskResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	if err := s.db.UnarchiveTask(ctx, in.GetId()); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ServerApi) ArchiveCompletedBefore(ctx context.Context, in *dbpb.ArchiveBeforeRequest) (*dbpb.ArchiveResponse, error) {
+	if in.Before == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid before")
+	}
+
+	archived, err := s.db.ArchiveCompletedBefore(ctx, in.GetBefore().AsTime())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.ArchiveResponse{
+		Archived: archived,
+	}, nil
+}
+
+func (s *ServerApi) ListArchivedTasks(ctx context.Context, in *dbpb.Empty) (*dbpb.TasksResponse, error) {
+	data, err := s.db.ListArchivedTasks(ctx)
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TasksResponse{
+		Tasks: toTaskItems(data),
+	}, nil
+}
+
+func (s *ServerApi) ListTrash(ctx context.Context, in *dbpb.Empty) (*dbpb.TasksResponse, error) {
+	data, err := s.db.ListTrash(ctx)
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TasksResponse{
+		Tasks: toTaskItems(data),
+	}, nil
+}
+
+func (s *ServerApi) RestoreTask(ctx context.Context, in *dbpb.TaskId) (*dbpb.TaskResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	if err := s.db.RestoreTask(ctx, in.GetId()); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ServerApi) PurgeTask(ctx context.Context, in *dbpb.TaskId) (*dbpb.TaskResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	if err := s.db.PurgeTask(ctx, in.GetId()); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ServerApi) CompleteTask(ctx context.Context, in *dbpb.CompleteTaskRequest) (*dbpb.TaskItemResponse, error) {
 	if in.Id < 0 {
 		return nil, status.Error(codes.InvalidArgument, "invalid id")
 	}
 
-	if err := s.db.CompleteTask(ctx, in.GetId()); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	data, err := s.db.CompleteTask(ctx, in.GetId(), in.GetCascade())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskItemResponse{
+		Task: toTaskItem(data),
+	}, nil
+}
+
+func (s *ServerApi) TransitionTask(ctx context.Context, in *dbpb.TransitionTaskRequest) (*dbpb.TaskResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	to := fromStatus(in.GetStatus())
+
+	if !service.ValidStatus(to) {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	if err := s.db.TransitionTask(ctx, in.GetId(), to); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ServerApi) ReopenTask(ctx context.Context, in *dbpb.TaskId) (*dbpb.TaskResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	if err := s.db.ReopenTask(ctx, in.GetId()); err != nil {
+		return nil, statusError(err)
 	}
 
 	return &dbpb.TaskResponse{
@@ -123,77 +329,337 @@ func (s *ServerApi) CompleteTask(ctx context.Context, in *dbpb.TaskId) (*dbpb.Ta
 	}, nil
 }
 
-func (s *ServerApi) ListTasks(ctx context.Context, in *dbpb.Empty) (*dbpb.TasksResponse, error) {
-	data, err := s.db.ListTasks(ctx)
+func (s *ServerApi) ListTasks(ctx context.Context, in *dbpb.ListTasksRequest) (*dbpb.TasksResponse, error) {
+	filter, err := toFilter(in.GetFilter())
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, invalidFilter(err)
 	}
 
-	var tasks []*dbpb.TaskItem
+	sort, err := toSort(in.GetSort())
 
-	for _, v := range data {
-		tasks = append(tasks, &dbpb.TaskItem{
-			Id:          v.ID,
-			Title:       v.Title,
-			Description: v.Description,
-			Completed:   v.Completed,
-			CreatedAt:   v.CreatedAt,
-			CompletedAt: v.CompletedAt,
-		})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := toPage(in.GetPageSize(), in.GetPageToken(), sort)
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	data, next, err := s.db.ListTasks(ctx, filter, page)
+
+	if err != nil {
+		return nil, statusError(err)
 	}
 
 	return &dbpb.TasksResponse{
-		Tasks: tasks,
+		Tasks:         toTaskItems(data),
+		NextPageToken: encodePageToken(next, page.Sort),
 	}, nil
 }
 
-func (s *ServerApi) ListCompletedTasks(ctx context.Context, in *dbpb.Empty) (*dbpb.TasksResponse, error) {
-	data, err := s.db.ListCompletedTasks(ctx)
+func (s *ServerApi) ListCompletedTasks(ctx context.Context, in *dbpb.PageRequest) (*dbpb.TasksResponse, error) {
+	page, err := toPage(in.GetPageSize(), in.GetPageToken(), nil)
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	var tasks []*dbpb.TaskItem
+	data, next, err := s.db.ListCompletedTasks(ctx, page)
 
-	for _, v := range data {
-		tasks = append(tasks, &dbpb.TaskItem{
-			Id:          v.ID,
-			Title:       v.Title,
-			Description: v.Description,
-			Completed:   v.Completed,
-			CreatedAt:   v.CreatedAt,
-			CompletedAt: v.CompletedAt,
-		})
+	if err != nil {
+		return nil, statusError(err)
 	}
 
 	return &dbpb.TasksResponse{
-		Tasks: tasks,
+		Tasks:         toTaskItems(data),
+		NextPageToken: encodePageToken(next, nil),
 	}, nil
 }
 
-func (s *ServerApi) ListNotCompletedTasks(ctx context.Context, in *dbpb.Empty) (*dbpb.TasksResponse, error) {
-	data, err := s.db.ListNotCompletedTasks(ctx)
+func (s *ServerApi) ListNotCompletedTasks(ctx context.Context, in *dbpb.PageRequest) (*dbpb.TasksResponse, error) {
+	page, err := toPage(in.GetPageSize(), in.GetPageToken(), nil)
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	var tasks []*dbpb.TaskItem
+	data, next, err := s.db.ListNotCompletedTasks(ctx, page)
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TasksResponse{
+		Tasks:         toTaskItems(data),
+		NextPageToken: encodePageToken(next, nil),
+	}, nil
+}
+
+func (s *ServerApi) ListTasksByPriority(ctx context.Context, in *dbpb.Empty) (*dbpb.TasksResponse, error) {
+	data, err := s.db.ListTasksByPriority(ctx)
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TasksResponse{
+		Tasks: toTaskItems(data),
+	}, nil
+}
+
+func (s *ServerApi) ListOverdueTasks(ctx context.Context, in *dbpb.Empty) (*dbpb.TasksResponse, error) {
+	data, err := s.db.ListOverdueTasks(ctx)
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TasksResponse{
+		Tasks: toTaskItems(data),
+	}, nil
+}
+
+func (s *ServerApi) ListDueBetween(ctx context.Context, in *dbpb.DueRangeRequest) (*dbpb.TasksResponse, error) {
+	if in.From == nil || in.To == nil || !in.To.AsTime().After(in.From.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid due range")
+	}
+
+	data, err := s.db.ListDueBetween(ctx, in.GetFrom().AsTime(), in.GetTo().AsTime())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TasksResponse{
+		Tasks: toTaskItems(data),
+	}, nil
+}
+
+func (s *ServerApi) AttachTag(ctx context.Context, in *dbpb.TagRequest) (*dbpb.TaskResponse, error) {
+	if in.TaskId < 1 || !tagPattern.MatchString(in.Tag) {
+		return nil, status.Error(codes.InvalidArgument, "invalid arguments")
+	}
+
+	if err := s.db.AttachTag(ctx, in.GetTaskId(), in.GetTag()); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ServerApi) DetachTag(ctx context.Context, in *dbpb.TagRequest) (*dbpb.TaskResponse, error) {
+	if in.TaskId < 1 || !tagPattern.MatchString(in.Tag) {
+		return nil, status.Error(codes.InvalidArgument, "invalid arguments")
+	}
+
+	if err := s.db.DetachTag(ctx, in.GetTaskId(), in.GetTag()); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ServerApi) ListTags(ctx context.Context, in *dbpb.Empty) (*dbpb.TagsResponse, error) {
+	data, err := s.db.ListTags(ctx)
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	var tags []*dbpb.TagItem
 
 	for _, v := range data {
-		tasks = append(tasks, &dbpb.TaskItem{
-			Id:          v.ID,
-			Title:       v.Title,
-			Description: v.Description,
-			Completed:   v.Completed,
-			CreatedAt:   v.CreatedAt,
-			CompletedAt: v.CompletedAt,
+		tags = append(tags, &dbpb.TagItem{
+			Name:  v.Name,
+			Count: v.Count,
 		})
 	}
 
+	return &dbpb.TagsResponse{
+		Tags: tags,
+	}, nil
+}
+
+func (s *ServerApi) ListTasksByTags(ctx context.Context, in *dbpb.TagFilterRequest) (*dbpb.TasksResponse, error) {
+	if len(in.Tags) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no tags")
+	}
+
+	for _, tag := range in.Tags {
+		if !tagPattern.MatchString(tag) {
+			return nil, status.Error(codes.InvalidArgument, "invalid tag")
+		}
+	}
+
+	data, err := s.db.ListTasksByTags(ctx, in.GetTags(), in.GetMatchAll())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TasksResponse{
+		Tasks: toTaskItems(data),
+	}, nil
+}
+
+func (s *ServerApi) ListSubtasks(ctx context.Context, in *dbpb.SubtasksRequest) (*dbpb.TasksResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	data, err := s.db.ListSubtasks(ctx, in.GetId(), in.GetRecursive())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
 	return &dbpb.TasksResponse{
-		Tasks: tasks,
+		Tasks: toTaskItems(data),
 	}, nil
 }
+
+func (s *ServerApi) MoveTask(ctx context.Context, in *dbpb.MoveTaskRequest) (*dbpb.TaskResponse, error) {
+	if in.Id < 1 || in.ParentId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid arguments")
+	}
+
+	if err := s.db.MoveTask(ctx, in.GetId(), in.GetParentId()); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ServerApi) AddDependency(ctx context.Context, in *dbpb.DependencyRequest) (*dbpb.TaskResponse, error) {
+	if in.TaskId < 1 || in.BlockedById < 1 || in.TaskId == in.BlockedById {
+		return nil, status.Error(codes.InvalidArgument, "invalid arguments")
+	}
+
+	if err := s.db.AddDependency(ctx, in.GetTaskId(), in.GetBlockedById()); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ServerApi) RemoveDependency(ctx context.Context, in *dbpb.DependencyRequest) (*dbpb.TaskResponse, error) {
+	if in.TaskId < 1 || in.BlockedById < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid arguments")
+	}
+
+	if err := s.db.RemoveDependency(ctx, in.GetTaskId(), in.GetBlockedById()); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ServerApi) ListBlockers(ctx context.Context, in *dbpb.TaskId) (*dbpb.TasksResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	data, err := s.db.ListBlockers(ctx, in.GetId())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TasksResponse{
+		Tasks: toTaskItems(data),
+	}, nil
+}
+
+func (s *ServerApi) ListReadyTasks(ctx context.Context, in *dbpb.Empty) (*dbpb.TasksResponse, error) {
+	data, err := s.db.ListReadyTasks(ctx)
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TasksResponse{
+		Tasks: toTaskItems(data),
+	}, nil
+}
+
+func validPriority(p dbpb.Priority) bool {
+	_, ok := dbpb.Priority_name[int32(p)]
+
+	return ok
+}
+
+func validRecurrence(rule string) error {
+	if rule == "" {
+		return nil
+	}
+
+	_, err := rrule.Parse(rule)
+
+	return err
+}
+
+func fromStatus(s dbpb.TaskStatus) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), statusPrefix))
+}
+
+func toStatus(s string) dbpb.TaskStatus {
+	return dbpb.TaskStatus(dbpb.TaskStatus_value[statusPrefix+strings.ToUpper(s)])
+}
+
+func toTaskItem(t models.Task) *dbpb.TaskItem {
+	item := &dbpb.TaskItem{
+		Id:              t.ID,
+		Title:           t.Title,
+		Description:     t.Description,
+		Completed:       t.Completed,
+		Status:          toStatus(t.Status),
+		StatusChangedAt: t.StatusChangedAt,
+		CreatedAt:       t.CreatedAt,
+		CompletedAt:     t.CompletedAt,
+		Priority:        dbpb.Priority(t.Priority),
+		DueAt:           t.DueAt,
+		Tags:            t.Tags,
+		ParentId:        t.ParentID,
+		Recurrence:      t.Recurrence,
+		ProjectId:       t.ProjectID,
+		DeletedAt:       t.DeletedAt,
+		ArchivedAt:      t.ArchivedAt,
+		Version:         t.Version,
+	}
+
+	if t.Status != models.StatusDone && t.Status != models.StatusCancelled {
+		if next, err := service.NextOccurrence(t, time.Now()); err == nil && next != nil {
+			item.NextOccurrenceAt = next.DueAt
+		}
+	}
+
+	return item
+}
+
+func toTaskItems(data []models.Task) []*dbpb.TaskItem {
+	var tasks []*dbpb.TaskItem
+
+	for _, v := range data {
+		tasks = append(tasks, toTaskItem(v))
+	}
+
+	return tasks
+}
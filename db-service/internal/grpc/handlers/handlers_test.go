@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"todo/db/internal/domain/models"
+	dbpb "todo/proto/db/gen"
+)
+
+// editDB records the task EditTask is called with; every other DB method panics.
+type editDB struct {
+	DB
+	edited models.Task
+}
+
+func (d *editDB) EditTask(_ context.Context, task models.Task) (models.Task, error) {
+	d.edited = task
+	return task, nil
+}
+
+func TestEditTaskKeepsOmittedFields(t *testing.T) {
+	db := &editDB{}
+	s := &ServerApi{db: db}
+
+	if _, err := s.EditTask(context.Background(), &dbpb.EditTaskRequest{Id: 1, Title: "Renamed"}); err != nil {
+		t.Fatalf("EditTask: %v", err)
+	}
+
+	e := db.edited
+
+	if e.Title != "Renamed" {
+		t.Errorf("EditTask: title = %q, want Renamed", e.Title)
+	}
+
+	if e.Description != "" {
+		t.Errorf("EditTask: description = %q, want empty to keep the stored one", e.Description)
+	}
+
+	if e.Priority != models.PriorityUnset {
+		t.Errorf("EditTask: priority = %d, want PriorityUnset", e.Priority)
+	}
+
+	if e.DueAt != nil {
+		t.Errorf("EditTask: due_at = %v, want nil to keep the stored one", e.DueAt)
+	}
+
+	if e.Recurrence != "" {
+		t.Errorf("EditTask: recurrence = %q, want empty to keep the stored one", e.Recurrence)
+	}
+
+	if e.ProjectID != 0 {
+		t.Errorf("EditTask: project_id = %d, want 0 to keep the stored one", e.ProjectID)
+	}
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"todo/db/internal/domain/models"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+var errPageToken = errors.New("invalid page token")
+
+// pageToken is the decoded form of a page token. Sort pins the token to the order it was
+// issued for, so that it can't be replayed against a different one.
+type pageToken struct {
+	Sort   string   `json:"s,omitempty"`
+	Values []string `json:"v"`
+	ID     int64    `json:"id"`
+}
+
+// toPage builds a page from the request fields. A zero size falls back to the default and
+// sizes above the maximum are capped.
+func toPage(size int32, token string, sort []models.SortKey) (models.Page, error) {
+	if size < 0 {
+		return models.Page{}, errors.New("invalid page size")
+	}
+
+	page := models.Page{Size: int(size), Sort: sort}
+
+	if page.Size == 0 {
+		page.Size = defaultPageSize
+	}
+
+	if page.Size > maxPageSize {
+		page.Size = maxPageSize
+	}
+
+	if token != "" {
+		cursor, err := decodePageToken(token, sort)
+
+		if err != nil {
+			return models.Page{}, err
+		}
+
+		page.After = &cursor
+	}
+
+	return page, nil
+}
+
+// encodePageToken turns a cursor into an opaque token. A nil cursor yields an empty token.
+func encodePageToken(c *models.Cursor, sort []models.SortKey) string {
+	if c == nil {
+		return ""
+	}
+
+	raw, _ := json.Marshal(pageToken{
+		Sort:   models.SortSpec(sort),
+		Values: c.Values,
+		ID:     c.ID,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodePageToken(token string, sort []models.SortKey) (models.Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+
+	if err != nil {
+		return models.Cursor{}, errPageToken
+	}
+
+	var t pageToken
+
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return models.Cursor{}, errPageToken
+	}
+
+	if t.ID < 1 || t.Sort != models.SortSpec(sort) {
+		return models.Cursor{}, errPageToken
+	}
+
+	keys := len(sort)
+
+	if keys == 0 {
+		keys = len(models.DefaultSort)
+	}
+
+	if len(t.Values) != keys {
+		return models.Cursor{}, errPageToken
+	}
+
+	return models.Cursor{Values: t.Values, ID: t.ID}, nil
+}
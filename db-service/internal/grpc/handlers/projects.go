@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"context"
+	"todo/db/internal/domain/models"
+	dbpb "todo/proto/db/gen"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Projects interface {
+	CreateProject(ctx context.Context, project models.Project) (models.Project, error)
+	GetProject(ctx context.Context, id int64) (models.Project, error)
+	EditProject(ctx context.Context, project models.Project) error
+	DeleteProject(ctx context.Context, id int64, cascade bool) error
+	ListProjects(ctx context.Context, includeArchived bool) ([]models.Project, error)
+	ListProjectTasks(ctx context.Context, id int64) ([]models.Task, error)
+}
+
+type ProjectServerApi struct {
+	dbpb.UnimplementedProjectServiceServer
+	projects Projects
+}
+
+func RegisterProjects(gRPCserver *grpc.Server, projects Projects) {
+	dbpb.RegisterProjectServiceServer(gRPCserver, &ProjectServerApi{projects: projects})
+}
+
+func (s *ProjectServerApi) CreateProject(ctx context.Context, in *dbpb.ProjectRequest) (*dbpb.ProjectItemResponse, error) {
+	if in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid arguments")
+	}
+
+	project, err := s.projects.CreateProject(ctx, models.Project{
+		Name:        in.GetName(),
+		Description: in.GetDescription(),
+	})
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.ProjectItemResponse{
+		Project: toProjectItem(project),
+	}, nil
+}
+
+func (s *ProjectServerApi) GetProject(ctx context.Context, in *dbpb.ProjectId) (*dbpb.ProjectItemResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	project, err := s.projects.GetProject(ctx, in.GetId())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.ProjectItemResponse{
+		Project: toProjectItem(project),
+	}, nil
+}
+
+func (s *ProjectServerApi) EditProject(ctx context.Context, in *dbpb.EditProjectRequest) (*dbpb.TaskResponse, error) {
+	if in.Id < 1 || in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid arguments")
+	}
+
+	if err := s.projects.EditProject(ctx, models.Project{
+		ID:          in.GetId(),
+		Name:        in.GetName(),
+		Description: in.GetDescription(),
+	}); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ProjectServerApi) DeleteProject(ctx context.Context, in *dbpb.DeleteProjectRequest) (*dbpb.TaskResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	if _, ok := dbpb.DeleteMode_name[int32(in.GetMode())]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid delete mode")
+	}
+
+	if err := s.projects.DeleteProject(ctx, in.GetId(), in.GetMode() == dbpb.DeleteMode_DELETE_MODE_CASCADE); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ProjectServerApi) ListProjects(ctx context.Context, in *dbpb.ListProjectsRequest) (*dbpb.ProjectsResponse, error) {
+	data, err := s.projects.ListProjects(ctx, in.GetIncludeArchived())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	var projects []*dbpb.ProjectItem
+
+	for _, v := range data {
+		projects = append(projects, toProjectItem(v))
+	}
+
+	return &dbpb.ProjectsResponse{
+		Projects: projects,
+	}, nil
+}
+
+func (s *ProjectServerApi) ListProjectTasks(ctx context.Context, in *dbpb.ProjectId) (*dbpb.TasksResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	data, err := s.projects.ListProjectTasks(ctx, in.GetId())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TasksResponse{
+		Tasks: toTaskItems(data),
+	}, nil
+}
+
+func toProjectItem(p models.Project) *dbpb.ProjectItem {
+	return &dbpb.ProjectItem{
+		Id:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+		ArchivedAt:  p.ArchivedAt,
+	}
+}
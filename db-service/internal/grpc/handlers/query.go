@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"todo/db/internal/domain/models"
+	"todo/db/internal/lib/filter"
+	"todo/db/internal/service"
+	dbpb "todo/proto/db/gen"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toSort checks the requested sort fields against the whitelist. Each field may appear once.
+func toSort(fields []*dbpb.SortField) ([]models.SortKey, error) {
+	sort := make([]models.SortKey, 0, len(fields))
+	seen := make(map[string]bool, len(fields))
+
+	for _, f := range fields {
+		if !service.ValidSortField(f.GetField()) {
+			return nil, fmt.Errorf("invalid sort field %q", f.GetField())
+		}
+
+		if seen[f.GetField()] {
+			return nil, fmt.Errorf("duplicate sort field %q", f.GetField())
+		}
+
+		seen[f.GetField()] = true
+		sort = append(sort, models.SortKey{Field: f.GetField(), Desc: f.GetDesc()})
+	}
+
+	return sort, nil
+}
+
+func toFilter(in *dbpb.TaskFilter) (models.TaskFilter, error) {
+	f := models.TaskFilter{
+		Query:      in.GetQ(),
+		Completed:  in.Completed,
+		Expression: in.GetExpression(),
+	}
+
+	if in.GetCreatedAfter() != nil {
+		if err := in.GetCreatedAfter().CheckValid(); err != nil {
+			return models.TaskFilter{}, errors.New("invalid created_after")
+		}
+
+		f.CreatedAfter = in.GetCreatedAfter().AsTime()
+	}
+
+	if in.GetCreatedBefore() != nil {
+		if err := in.GetCreatedBefore().CheckValid(); err != nil {
+			return models.TaskFilter{}, errors.New("invalid created_before")
+		}
+
+		f.CreatedBefore = in.GetCreatedBefore().AsTime()
+	}
+
+	if !f.CreatedAfter.IsZero() && !f.CreatedBefore.IsZero() && !f.CreatedAfter.Before(f.CreatedBefore) {
+		return models.TaskFilter{}, errors.New("created_after must be before created_before")
+	}
+
+	if in.GetExpression() != "" {
+		expr, err := filter.Parse(in.GetExpression())
+
+		if err != nil {
+			return models.TaskFilter{}, err
+		}
+
+		f.Expr = expr
+	}
+
+	return f, nil
+}
+
+// toFilterMessage is the inverse of toFilter.
+func toFilterMessage(f models.TaskFilter) *dbpb.TaskFilter {
+	out := &dbpb.TaskFilter{
+		Q:          f.Query,
+		Completed:  f.Completed,
+		Expression: f.Expression,
+	}
+
+	if !f.CreatedAfter.IsZero() {
+		out.CreatedAfter = timestamppb.New(f.CreatedAfter)
+	}
+
+	if !f.CreatedBefore.IsZero() {
+		out.CreatedBefore = timestamppb.New(f.CreatedBefore)
+	}
+
+	return out
+}
+
+func toSortFields(sort []models.SortKey) []*dbpb.SortField {
+	fields := make([]*dbpb.SortField, 0, len(sort))
+
+	for _, key := range sort {
+		fields = append(fields, &dbpb.SortField{Field: key.Field, Desc: key.Desc})
+	}
+
+	return fields
+}
+
+// invalidFilter turns a toFilter error into an InvalidArgument status. A filter expression
+// error carries an ErrorInfo detail with the offending span, so that the gateway can point at it.
+func invalidFilter(err error) error {
+	var ferr *filter.Error
+
+	if !errors.As(err, &ferr) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	st, detailErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: "INVALID_FILTER",
+		Domain: "todo.db",
+		Metadata: map[string]string{
+			"pos":     strconv.Itoa(ferr.Pos),
+			"end":     strconv.Itoa(ferr.End),
+			"message": ferr.Msg,
+		},
+	})
+
+	if detailErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return st.Err()
+}
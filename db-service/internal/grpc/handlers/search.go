@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"todo/db/internal/lib/search"
+	"todo/db/internal/service"
+	dbpb "todo/proto/db/gen"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	maxSearchQuery     = 256
+)
+
+func (s *ServerApi) SearchTasks(ctx context.Context, in *dbpb.SearchTasksRequest) (*dbpb.SearchTasksResponse, error) {
+	if strings.TrimSpace(in.GetQuery()) == "" || utf8.RuneCountInString(in.GetQuery()) > maxSearchQuery {
+		return nil, status.Error(codes.InvalidArgument, "invalid query")
+	}
+
+	if in.GetLanguage() != "" && !service.ValidSearchLanguage(in.GetLanguage()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid language")
+	}
+
+	if in.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid limit")
+	}
+
+	limit := int(in.GetLimit())
+
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	limit = min(limit, maxSearchLimit)
+
+	hits, err := s.db.SearchTasks(ctx, in.GetQuery(), in.GetLanguage(), limit)
+
+	if err != nil {
+		if errors.Is(err, search.ErrEmptyQuery) {
+			return nil, status.Error(codes.InvalidArgument, "query has no searchable words")
+		}
+
+		return nil, statusError(err)
+	}
+
+	resp := &dbpb.SearchTasksResponse{
+		Hits: make([]*dbpb.SearchHit, 0, len(hits)),
+	}
+
+	for _, hit := range hits {
+		resp.Hits = append(resp.Hits, &dbpb.SearchHit{
+			Task:    toTaskItem(hit.Task),
+			Rank:    hit.Rank,
+			Snippet: hit.Snippet,
+		})
+	}
+
+	return resp, nil
+}
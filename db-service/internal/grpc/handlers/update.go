@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"todo/db/internal/domain/models"
+	"todo/db/internal/lib/validate"
+	dbpb "todo/proto/db/gen"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// updatableFields lists the field mask paths UpdateTask accepts.
+var updatableFields = map[string]bool{
+	"title":       true,
+	"description": true,
+	"priority":    true,
+	"due_at":      true,
+	"recurrence":  true,
+	"project_id":  true,
+}
+
+func (s *ServerApi) UpdateTask(ctx context.Context, in *dbpb.UpdateTaskRequest) (*dbpb.TaskItemResponse, error) {
+	item := in.GetTask()
+
+	if item.GetId() < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	paths := in.GetUpdateMask().GetPaths()
+
+	if len(paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty update mask")
+	}
+
+	var v validate.Validator
+
+	fields := make([]string, 0, len(paths))
+	seen := make(map[string]bool, len(paths))
+
+	for _, path := range paths {
+		if !updatableFields[path] {
+			v.Add(path, "cannot be updated")
+			continue
+		}
+
+		if seen[path] {
+			continue
+		}
+
+		seen[path] = true
+		fields = append(fields, path)
+	}
+
+	task := models.Task{
+		ID:          item.GetId(),
+		Title:       item.GetTitle(),
+		Description: item.GetDescription(),
+		Priority:    int32(item.GetPriority()),
+		DueAt:       item.GetDueAt(),
+		Recurrence:  item.GetRecurrence(),
+		ProjectID:   item.GetProjectId(),
+		Version:     item.GetVersion(),
+	}
+
+	validateTask(&v, &task, seen)
+
+	if !v.Valid() {
+		return nil, invalidArgument(v.Violations())
+	}
+
+	data, err := s.db.UpdateTask(ctx, task, fields)
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskItemResponse{
+		Task: toTaskItem(data),
+	}, nil
+}
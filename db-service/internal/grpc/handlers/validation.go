@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"strings"
+	"todo/db/internal/domain/models"
+	"todo/db/internal/lib/validate"
+	dbpb "todo/proto/db/gen"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxTitleLength       = 200
+	maxDescriptionLength = 10000
+)
+
+// Rules for the text fields of a task. They are only checked here; the gateway passes the
+// violations on to REST clients.
+var (
+	titleRules       = []validate.Rule{validate.Required, validate.MaxLength(maxTitleLength), validate.SingleLine}
+	descriptionRules = []validate.Rule{validate.MaxLength(maxDescriptionLength), validate.MultiLine}
+)
+
+// validateTask checks the writable fields of a task and trims its text fields in place. When
+// fields is not nil only the field mask paths it contains are checked. project_id is only
+// checked for a patch, where zero detaches the task; create and edit requests check their
+// optional ids with validID.
+func validateTask(v *validate.Validator, task *models.Task, fields map[string]bool) {
+	check := func(path string) bool {
+		return fields == nil || fields[path]
+	}
+
+	if check("title") {
+		v.String("title", &task.Title, titleRules...)
+	}
+
+	if check("description") {
+		v.String("description", &task.Description, descriptionRules...)
+	}
+
+	if check("priority") && task.Priority != models.PriorityUnset {
+		v.Check("priority", validPriority(dbpb.Priority(task.Priority)), "is not a valid priority")
+	}
+
+	if check("recurrence") {
+		if err := validRecurrence(task.Recurrence); err != nil {
+			v.Add("recurrence", err.Error())
+		}
+	}
+
+	if fields["project_id"] {
+		v.Check("project_id", task.ProjectID >= 0, "must not be negative")
+	}
+}
+
+// validID checks an id a request may leave unset. A set id has to name a row, so it must be
+// positive.
+func validID(v *validate.Validator, field string, id *int64) {
+	if id != nil {
+		v.Check(field, *id > 0, "must be a positive integer")
+	}
+}
+
+// invalidArgument reports every violation in one InvalidArgument status. The violations are
+// attached as a BadRequest detail, so callers can match them to their fields.
+func invalidArgument(violations []validate.Violation) error {
+	var (
+		details  errdetails.BadRequest
+		messages = make([]string, 0, len(violations))
+	)
+
+	for _, v := range violations {
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Message,
+		})
+		messages = append(messages, v.Field+" "+v.Message)
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid arguments: "+strings.Join(messages, "; "))
+
+	if detailed, err := st.WithDetails(&details); err == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
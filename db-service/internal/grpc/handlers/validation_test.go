@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+	"testing"
+	dbpb "todo/proto/db/gen"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateTaskViolations(t *testing.T) {
+	s := &ServerApi{}
+
+	parentID, projectID := int64(-1), int64(0)
+
+	_, err := s.CreateTask(context.Background(), &dbpb.TaskRequest{
+		Title:       " \t",
+		Description: "line\x1b[31m",
+		Priority:    dbpb.Priority(42),
+		Recurrence:  "FREQ=HOURLY",
+		ParentId:    &parentID,
+		ProjectId:   &projectID,
+	})
+
+	st := status.Convert(err)
+
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("CreateTask: code = %v, want InvalidArgument", st.Code())
+	}
+
+	var fields []string
+
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			for _, fv := range br.GetFieldViolations() {
+				fields = append(fields, fv.GetField())
+			}
+		}
+	}
+
+	want := []string{"title", "description", "priority", "recurrence", "parent_id", "project_id"}
+
+	if !slices.Equal(fields, want) {
+		t.Fatalf("CreateTask: violations = %v, want %v", fields, want)
+	}
+}
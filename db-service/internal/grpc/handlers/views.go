@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"context"
+	"todo/db/internal/domain/models"
+	dbpb "todo/proto/db/gen"
+	"unicode/utf8"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxViewOwner = 64
+	maxViewName  = 100
+)
+
+type Views interface {
+	CreateView(ctx context.Context, view models.SavedView) (models.SavedView, error)
+	GetView(ctx context.Context, id int64, owner string) (models.SavedView, error)
+	EditView(ctx context.Context, view models.SavedView) error
+	DeleteView(ctx context.Context, id int64, owner string) error
+	ListViews(ctx context.Context, owner string) ([]models.SavedView, error)
+	ListViewTasks(ctx context.Context, view models.SavedView, page models.Page) ([]models.Task, *models.Cursor, error)
+}
+
+type ViewServerApi struct {
+	dbpb.UnimplementedViewServiceServer
+	views Views
+}
+
+func RegisterViews(gRPCserver *grpc.Server, views Views) {
+	dbpb.RegisterViewServiceServer(gRPCserver, &ViewServerApi{views: views})
+}
+
+func (s *ViewServerApi) CreateView(ctx context.Context, in *dbpb.ViewRequest) (*dbpb.ViewItemResponse, error) {
+	if !validText(in.GetOwner(), maxViewOwner) {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner")
+	}
+
+	view, err := toView(in.GetName(), in.GetFilter(), in.GetSort())
+
+	if err != nil {
+		return nil, err
+	}
+
+	view.Owner = in.GetOwner()
+
+	created, err := s.views.CreateView(ctx, view)
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.ViewItemResponse{
+		View: toViewItem(created),
+	}, nil
+}
+
+func (s *ViewServerApi) GetView(ctx context.Context, in *dbpb.ViewId) (*dbpb.ViewItemResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	if !validText(in.GetOwner(), maxViewOwner) {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner")
+	}
+
+	view, err := s.views.GetView(ctx, in.GetId(), in.GetOwner())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.ViewItemResponse{
+		View: toViewItem(view),
+	}, nil
+}
+
+func (s *ViewServerApi) EditView(ctx context.Context, in *dbpb.EditViewRequest) (*dbpb.TaskResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	if !validText(in.GetOwner(), maxViewOwner) {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner")
+	}
+
+	view, err := toView(in.GetName(), in.GetFilter(), in.GetSort())
+
+	if err != nil {
+		return nil, err
+	}
+
+	view.ID = in.GetId()
+	view.Owner = in.GetOwner()
+
+	if err := s.views.EditView(ctx, view); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ViewServerApi) DeleteView(ctx context.Context, in *dbpb.ViewId) (*dbpb.TaskResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	if !validText(in.GetOwner(), maxViewOwner) {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner")
+	}
+
+	if err := s.views.DeleteView(ctx, in.GetId(), in.GetOwner()); err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TaskResponse{
+		Status:  codes.OK.String(),
+		Message: "success",
+	}, nil
+}
+
+func (s *ViewServerApi) ListViews(ctx context.Context, in *dbpb.ListViewsRequest) (*dbpb.ViewsResponse, error) {
+	if !validText(in.GetOwner(), maxViewOwner) {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner")
+	}
+
+	data, err := s.views.ListViews(ctx, in.GetOwner())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	views := make([]*dbpb.ViewItem, 0, len(data))
+
+	for _, v := range data {
+		views = append(views, toViewItem(v))
+	}
+
+	return &dbpb.ViewsResponse{
+		Views: views,
+	}, nil
+}
+
+// ListViewTasks runs a saved view. Page tokens are tied to the view's sort order, so a token
+// stops working once the view's sort is edited.
+func (s *ViewServerApi) ListViewTasks(ctx context.Context, in *dbpb.ListViewTasksRequest) (*dbpb.TasksResponse, error) {
+	if in.Id < 1 {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	if !validText(in.GetOwner(), maxViewOwner) {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner")
+	}
+
+	view, err := s.views.GetView(ctx, in.GetId(), in.GetOwner())
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	page, err := toPage(in.GetPageSize(), in.GetPageToken(), view.Sort)
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	data, next, err := s.views.ListViewTasks(ctx, view, page)
+
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &dbpb.TasksResponse{
+		Tasks:         toTaskItems(data),
+		NextPageToken: encodePageToken(next, page.Sort),
+	}, nil
+}
+
+// toView validates the editable part of a view. The error is a gRPC status.
+func toView(name string, in *dbpb.TaskFilter, sortFields []*dbpb.SortField) (models.SavedView, error) {
+	if !validText(name, maxViewName) {
+		return models.SavedView{}, status.Error(codes.InvalidArgument, "invalid name")
+	}
+
+	f, err := toFilter(in)
+
+	if err != nil {
+		return models.SavedView{}, invalidFilter(err)
+	}
+
+	sort, err := toSort(sortFields)
+
+	if err != nil {
+		return models.SavedView{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	f.Expr = nil
+
+	return models.SavedView{Name: name, Filter: f, Sort: sort}, nil
+}
+
+func toViewItem(v models.SavedView) *dbpb.ViewItem {
+	return &dbpb.ViewItem{
+		Id:        v.ID,
+		Owner:     v.Owner,
+		Name:      v.Name,
+		Filter:    toFilterMessage(v.Filter),
+		Sort:      toSortFields(v.Sort),
+		CreatedAt: v.CreatedAt,
+	}
+}
+
+func validText(s string, max int) bool {
+	return s != "" && utf8.RuneCountInString(s) <= max
+}
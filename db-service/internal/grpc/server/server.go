@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"net"
@@ -11,6 +12,7 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 type Server struct {
@@ -19,12 +21,21 @@ type Server struct {
 	port       int
 }
 
-func New(log *slog.Logger, taskService *service.TaskService, port int) *Server {
+func New(
+	log *slog.Logger,
+	taskService *service.TaskService,
+	projectService *service.ProjectService,
+	viewService *service.ViewService,
+	port int,
+) *Server {
 	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
 		recovery.UnaryServerInterceptor(),
+		contextStatus,
 	))
 
 	handlers.Register(server, taskService)
+	handlers.RegisterProjects(server, projectService)
+	handlers.RegisterViews(server, viewService)
 
 	reflection.Register(server)
 
@@ -74,3 +85,21 @@ func (s *Server) Stop() {
 
 	s.gRPCserver.GracefulStop()
 }
+
+// contextStatus answers a call that failed after the caller gave up or its deadline passed with
+// Canceled or DeadlineExceeded, instead of the Internal error the storage layer reports for
+// an interrupted query.
+func contextStatus(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	resp, err := handler(ctx, req)
+
+	if err != nil && ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	return resp, err
+}
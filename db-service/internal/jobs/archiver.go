@@ -0,0 +1,73 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+	"todo/db/internal/lib/sl"
+)
+
+type CompletedArchiver interface {
+	ArchiveCompletedBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Archiver periodically archives the tasks that were completed longer ago than the configured age.
+type Archiver struct {
+	runner
+	log      *slog.Logger
+	archiver CompletedArchiver
+	after    time.Duration
+}
+
+func NewArchiver(
+	log *slog.Logger,
+	archiver CompletedArchiver,
+	after time.Duration,
+	interval time.Duration,
+) *Archiver {
+	return &Archiver{
+		runner:   newRunner(interval),
+		log:      log,
+		archiver: archiver,
+		after:    after,
+	}
+}
+
+// Run archives old completed tasks once and then on every interval until Stop is called.
+func (a *Archiver) Run() {
+	a.run(a.archive)
+}
+
+func (a *Archiver) Stop() {
+	const op = "jobs.Archiver.Stop"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("stopping auto-archiver")
+
+	a.halt()
+}
+
+func (a *Archiver) archive() {
+	const op = "jobs.Archiver.archive"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.interval)
+	defer cancel()
+
+	archived, err := a.archiver.ArchiveCompletedBefore(ctx, time.Now().Add(-a.after))
+
+	if err != nil {
+		log.Error("failed to archive completed tasks", sl.Err(err))
+		return
+	}
+
+	if archived > 0 {
+		log.Info("completed tasks archived", slog.Int64("tasks", archived))
+	}
+}
@@ -0,0 +1,76 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+	"todo/db/internal/lib/sl"
+)
+
+type TrashPurger interface {
+	PurgeTrash(ctx context.Context, before time.Time) (int64, error)
+	PurgeIdempotencyKeys(ctx context.Context) (int64, error)
+}
+
+// Purger periodically hard-deletes the tasks that have been in the trash longer than the
+// retention, together with the expired idempotency keys.
+type Purger struct {
+	runner
+	log       *slog.Logger
+	trash     TrashPurger
+	retention time.Duration
+}
+
+func NewPurger(
+	log *slog.Logger,
+	trash TrashPurger,
+	retention time.Duration,
+	interval time.Duration,
+) *Purger {
+	return &Purger{
+		runner:    newRunner(interval),
+		log:       log,
+		trash:     trash,
+		retention: retention,
+	}
+}
+
+// Run purges once and then on every interval until Stop is called.
+func (p *Purger) Run() {
+	p.run(p.purge)
+}
+
+func (p *Purger) Stop() {
+	const op = "jobs.Purger.Stop"
+
+	log := p.log.With(
+		slog.String("op", op),
+	)
+
+	log.Info("stopping trash purger")
+
+	p.halt()
+}
+
+func (p *Purger) purge() {
+	const op = "jobs.Purger.purge"
+
+	log := p.log.With(
+		slog.String("op", op),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.interval)
+	defer cancel()
+
+	if purged, err := p.trash.PurgeTrash(ctx, time.Now().Add(-p.retention)); err != nil {
+		log.Error("failed to purge trash", sl.Err(err))
+	} else if purged > 0 {
+		log.Info("trash purged", slog.Int64("tasks", purged))
+	}
+
+	if keys, err := p.trash.PurgeIdempotencyKeys(ctx); err != nil {
+		log.Error("failed to purge idempotency keys", sl.Err(err))
+	} else if keys > 0 {
+		log.Info("idempotency keys purged", slog.Int64("keys", keys))
+	}
+}
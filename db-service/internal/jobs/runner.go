@@ -0,0 +1,40 @@
+package jobs
+
+import "time"
+
+// runner calls a function right away and then on every interval until halted.
+type runner struct {
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func newRunner(interval time.Duration) runner {
+	return runner{
+		interval: interval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+func (r *runner) run(tick func()) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		tick()
+
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *runner) halt() {
+	close(r.stop)
+	<-r.done
+}
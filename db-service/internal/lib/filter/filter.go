@@ -0,0 +1,386 @@
+// Package filter parses the task filter language, e.g.
+//
+//	completed:false AND (title:deploy OR description~"hotfix") AND created>-7d
+//
+// A comparison is a field, an operator and a value. ":" tests equality and "!=" its negation,
+// "~" tests that a text field contains the value and "<", "<=", ">", ">=" compare priorities
+// and times. Text comparisons ignore case. Times are dates (2025-01-02), RFC 3339 timestamps
+// or offsets from now such as -7d, +12h or -2w. Comparisons combine with AND, OR, NOT and
+// parentheses; NOT binds tightest and AND binds tighter than OR. Values with spaces go in
+// double quotes.
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxLength bounds the length of a filter in runes.
+	MaxLength = 1024
+
+	// maxDepth bounds the nesting of parentheses and NOT.
+	maxDepth = 32
+)
+
+// Error is a syntax or type error. Pos and End are the rune offsets of the offending span,
+// End exclusive, so a client can underline it.
+type Error struct {
+	Pos int
+	End int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("filter: %s at offset %d", e.Msg, e.Pos)
+}
+
+type Op string
+
+const (
+	Eq       Op = ":"
+	Ne       Op = "!="
+	Contains Op = "~"
+	Lt       Op = "<"
+	Le       Op = "<="
+	Gt       Op = ">"
+	Ge       Op = ">="
+)
+
+// Kind is the type of a field, which decides the operators and values it accepts.
+type Kind int
+
+const (
+	Text Kind = iota
+	Tag
+	Bool
+	Status
+	Priority
+	Time
+)
+
+// Expr is a node of a parsed filter: *And, *Or, *Not or *Cmp.
+type Expr interface {
+	expr()
+}
+
+type And struct {
+	Left, Right Expr
+}
+
+type Or struct {
+	Left, Right Expr
+}
+
+type Not struct {
+	X Expr
+}
+
+// Cmp compares a field with a value. Only the value field matching the field's kind is set:
+// Text for Text, Tag and Status, Bool for Bool, Rank for Priority and Time or Offset for Time.
+type Cmp struct {
+	Field string
+	Kind  Kind
+	Op    Op
+
+	Text     string
+	Bool     bool
+	Rank     int
+	Time     time.Time
+	Offset   time.Duration
+	Relative bool
+}
+
+func (*And) expr() {}
+func (*Or) expr()  {}
+func (*Not) expr() {}
+func (*Cmp) expr() {}
+
+// At resolves the compared time, applying a relative offset to now.
+func (c *Cmp) At(now time.Time) time.Time {
+	if c.Relative {
+		return now.Add(c.Offset)
+	}
+
+	return c.Time
+}
+
+var fields = map[string]Kind{
+	"title":       Text,
+	"description": Text,
+	"tag":         Tag,
+	"completed":   Bool,
+	"status":      Status,
+	"priority":    Priority,
+	"created":     Time,
+	"due":         Time,
+}
+
+var operators = map[Kind][]Op{
+	Text:     {Eq, Ne, Contains},
+	Tag:      {Eq, Ne},
+	Bool:     {Eq, Ne},
+	Status:   {Eq, Ne},
+	Priority: {Eq, Ne, Lt, Le, Gt, Ge},
+	Time:     {Lt, Le, Gt, Ge},
+}
+
+var (
+	statuses   = []string{"todo", "in_progress", "review", "done", "cancelled"}
+	priorities = []string{"none", "low", "medium", "high", "urgent"}
+)
+
+var offsetPattern = regexp.MustCompile(`^([+-])(\d{1,5})([hdw])$`)
+
+// Parse parses a filter. Errors are *Error.
+func Parse(src string) (Expr, error) {
+	if n := utf8.RuneCountInString(src); n > MaxLength {
+		return nil, &Error{Pos: MaxLength, End: n, Msg: fmt.Sprintf("filter is longer than %d characters", MaxLength)}
+	}
+
+	toks, err := lex(src)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{toks: toks}
+
+	if p.peek().kind == tokEOF {
+		return nil, &Error{Msg: "empty filter"}
+	}
+
+	expr, err := p.parseOr()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, &Error{Pos: t.pos, End: t.end, Msg: "expected AND, OR or end of filter, got " + t.describe()}
+	}
+
+	return expr, nil
+}
+
+type parser struct {
+	toks  []token
+	i     int
+	depth int
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+
+	if t.kind != tokEOF {
+		p.i++
+	}
+
+	return t
+}
+
+func (p *parser) keyword(kw string) bool {
+	t := p.peek()
+
+	return t.kind == tokWord && strings.EqualFold(t.text, kw)
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for p.keyword("OR") {
+		p.next()
+
+		right, err := p.parseAnd()
+
+		if err != nil {
+			return nil, err
+		}
+
+		left = &Or{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseNot()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for p.keyword("AND") {
+		p.next()
+
+		right, err := p.parseNot()
+
+		if err != nil {
+			return nil, err
+		}
+
+		left = &And{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if !p.keyword("NOT") {
+		return p.parsePrimary()
+	}
+
+	t := p.next()
+
+	if err := p.enter(t); err != nil {
+		return nil, err
+	}
+
+	x, err := p.parseNot()
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.depth--
+
+	return &Not{X: x}, nil
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokLParen:
+		if err := p.enter(t); err != nil {
+			return nil, err
+		}
+
+		expr, err := p.parseOr()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, &Error{Pos: closing.pos, End: closing.end, Msg: "expected ), got " + closing.describe()}
+		}
+
+		p.depth--
+
+		return expr, nil
+	case tokWord:
+		return p.parseCmp(t)
+	default:
+		return nil, &Error{Pos: t.pos, End: t.end, Msg: "expected a field or (, got " + t.describe()}
+	}
+}
+
+func (p *parser) enter(t token) error {
+	if p.depth++; p.depth > maxDepth {
+		return &Error{Pos: t.pos, End: t.end, Msg: fmt.Sprintf("filter is nested deeper than %d levels", maxDepth)}
+	}
+
+	return nil
+}
+
+func (p *parser) parseCmp(field token) (Expr, error) {
+	name := strings.ToLower(field.text)
+	kind, ok := fields[name]
+
+	if !ok {
+		return nil, &Error{Pos: field.pos, End: field.end, Msg: fmt.Sprintf("unknown field %q", field.text)}
+	}
+
+	opTok := p.next()
+
+	if opTok.kind != tokOp {
+		return nil, &Error{Pos: opTok.pos, End: opTok.end, Msg: fmt.Sprintf("expected an operator after %q, got %s", field.text, opTok.describe())}
+	}
+
+	op := Op(opTok.text)
+
+	if !slices.Contains(operators[kind], op) {
+		return nil, &Error{Pos: opTok.pos, End: opTok.end, Msg: fmt.Sprintf("operator %q is not supported by %q", op, name)}
+	}
+
+	value := p.next()
+
+	if value.kind != tokWord && value.kind != tokString {
+		return nil, &Error{Pos: value.pos, End: value.end, Msg: "expected a value, got " + value.describe()}
+	}
+
+	cmp := &Cmp{Field: name, Kind: kind, Op: op}
+
+	if err := cmp.setValue(value.text); err != nil {
+		return nil, &Error{Pos: value.pos, End: value.end, Msg: err.Error()}
+	}
+
+	return cmp, nil
+}
+
+func (c *Cmp) setValue(v string) error {
+	switch c.Kind {
+	case Text:
+		c.Text = v
+	case Tag:
+		c.Text = strings.ToLower(v)
+	case Bool:
+		b, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return fmt.Errorf("%q expects true or false", c.Field)
+		}
+
+		c.Bool = b
+	case Status:
+		if c.Text = strings.ToLower(v); !slices.Contains(statuses, c.Text) {
+			return fmt.Errorf("%q expects one of %s", c.Field, strings.Join(statuses, ", "))
+		}
+	case Priority:
+		if c.Rank = slices.Index(priorities, strings.ToLower(v)); c.Rank < 0 {
+			return fmt.Errorf("%q expects one of %s", c.Field, strings.Join(priorities, ", "))
+		}
+	case Time:
+		return c.setTime(v)
+	}
+
+	return nil
+}
+
+func (c *Cmp) setTime(v string) error {
+	if m := offsetPattern.FindStringSubmatch(v); m != nil {
+		n, _ := strconv.Atoi(m[2])
+		unit := map[string]time.Duration{"h": time.Hour, "d": 24 * time.Hour, "w": 7 * 24 * time.Hour}[m[3]]
+
+		c.Offset, c.Relative = time.Duration(n)*unit, true
+
+		if m[1] == "-" {
+			c.Offset = -c.Offset
+		}
+
+		return nil
+	}
+
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, v); err == nil {
+			c.Time = t
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%q expects a date, an RFC 3339 time or an offset such as -7d", c.Field)
+}
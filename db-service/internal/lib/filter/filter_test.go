@@ -0,0 +1,98 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// show renders an expression as an s-expression so that tests can compare trees.
+func show(e Expr) string {
+	switch e := e.(type) {
+	case *And:
+		return "(and " + show(e.Left) + " " + show(e.Right) + ")"
+	case *Or:
+		return "(or " + show(e.Left) + " " + show(e.Right) + ")"
+	case *Not:
+		return "(not " + show(e.X) + ")"
+	case *Cmp:
+		var v any
+
+		switch e.Kind {
+		case Bool:
+			v = e.Bool
+		case Priority:
+			v = e.Rank
+		case Time:
+			if e.Relative {
+				v = e.Offset
+			} else {
+				v = e.Time.Format(time.RFC3339)
+			}
+		default:
+			v = fmt.Sprintf("%q", e.Text)
+		}
+
+		return fmt.Sprintf("%s%s%v", e.Field, e.Op, v)
+	}
+
+	return "?"
+}
+
+func TestParse(t *testing.T) {
+	tests := map[string]string{
+		`completed:false AND (title:deploy OR description~"hotfix") AND created>-7d`: `(and (and completed:false (or title:"deploy" description~"hotfix")) created>-168h0m0s)`,
+		`title:a OR title:b AND NOT title:c`:                                         `(or title:"a" (and title:"b" (not title:"c")))`,
+		`priority>=HIGH and status!=done`:                                            `(and priority>=3 status!="done")`,
+		`due<2025-01-02T10:00:00Z or due<+12h`:                                       `(or due<2025-01-02T10:00:00Z due<12h0m0s)`,
+		`tag:Backend AND title:"a \"quoted\" (x)"`:                                   `(and tag:"backend" title:"a \"quoted\" (x)")`,
+		`title:a:b`: `title:"a:b"`,
+	}
+
+	for in, want := range tests {
+		expr, err := Parse(in)
+
+		if err != nil {
+			t.Fatalf("Parse(%q): unexpected error %v", in, err)
+		}
+
+		if got := show(expr); got != want {
+			t.Fatalf("Parse(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		in       string
+		pos, end int
+	}{
+		{"", 0, 0},
+		{"titel:x", 0, 5},
+		{"title x", 6, 7},
+		{"title:", 6, 6},
+		{"created:2025-01-01", 7, 8},
+		{"completed:maybe", 10, 15},
+		{"priority>huge", 9, 13},
+		{"(title:a OR title:b", 19, 19},
+		{"title:a title:b", 8, 13},
+		{`title:"open`, 6, 11},
+		{"title=a", 5, 6},
+		{"due>yesterday", 4, 13},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(tt.in)
+
+		var ferr *Error
+
+		if !errors.As(err, &ferr) {
+			t.Fatalf("Parse(%q): expected *Error, got %v", tt.in, err)
+		}
+
+		if ferr.Pos != tt.pos || ferr.End != tt.end {
+			t.Fatalf("Parse(%q): error %q at %d-%d, want %d-%d", tt.in, ferr.Msg, ferr.Pos, ferr.End, tt.pos, tt.end)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package filter
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokLParen
+	tokRParen
+	tokOp
+	tokWord
+	tokString
+)
+
+type token struct {
+	kind     tokenKind
+	text     string
+	pos, end int
+}
+
+func (t token) describe() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of filter"
+	case tokString:
+		return "string " + `"` + t.text + `"`
+	default:
+		return `"` + t.text + `"`
+	}
+}
+
+func isOpRune(r rune) bool {
+	return strings.ContainsRune(":~!<>=", r)
+}
+
+// lex splits src into tokens. Positions are rune offsets. A bare word right after an operator
+// is a value and may itself contain operator characters, so created>2025-01-02T10:00:00Z
+// needs no quotes.
+func lex(src string) ([]token, error) {
+	runes := []rune(src)
+
+	var toks []token
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		afterOp := len(toks) > 0 && toks[len(toks)-1].kind == tokOp
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			toks = append(toks, token{kind: tokLParen, text: "(", pos: i, end: i + 1})
+			i++
+		case r == ')':
+			toks = append(toks, token{kind: tokRParen, text: ")", pos: i, end: i + 1})
+			i++
+		case r == '"':
+			var b strings.Builder
+
+			j, closed := i+1, false
+
+			for j < len(runes) {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					b.WriteRune(runes[j+1])
+					j += 2
+
+					continue
+				}
+
+				if runes[j] == '"' {
+					closed = true
+					j++
+
+					break
+				}
+
+				b.WriteRune(runes[j])
+				j++
+			}
+
+			if !closed {
+				return nil, &Error{Pos: i, End: len(runes), Msg: "unterminated string"}
+			}
+
+			toks = append(toks, token{kind: tokString, text: b.String(), pos: i, end: j})
+			i = j
+		case isOpRune(r) && !afterOp:
+			j := i + 1
+
+			if j < len(runes) && runes[j] == '=' && (r == '!' || r == '<' || r == '>') {
+				j++
+			}
+
+			op := string(runes[i:j])
+
+			if op == "!" || op == "=" {
+				return nil, &Error{Pos: i, End: j, Msg: `unexpected "` + op + `"`}
+			}
+
+			toks = append(toks, token{kind: tokOp, text: op, pos: i, end: j})
+			i = j
+		default:
+			j := i
+
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && runes[j] != '(' && runes[j] != ')' &&
+				runes[j] != '"' && (afterOp || !isOpRune(runes[j])) {
+				j++
+			}
+
+			toks = append(toks, token{kind: tokWord, text: string(runes[i:j]), pos: i, end: j})
+			i = j
+		}
+	}
+
+	return append(toks, token{kind: tokEOF, pos: len(runes), end: len(runes)}), nil
+}
@@ -0,0 +1,280 @@
+// Package rrule implements the subset of iCalendar (RFC 5545) recurrence rules
+// supported for recurring tasks: FREQ=DAILY|WEEKLY|MONTHLY with INTERVAL, BYDAY
+// and either UNTIL or COUNT.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Freq string
+
+const (
+	Daily   Freq = "DAILY"
+	Weekly  Freq = "WEEKLY"
+	Monthly Freq = "MONTHLY"
+)
+
+const (
+	untilDate     = "20060102"
+	untilDateTime = "20060102T150405Z"
+
+	// maxSteps bounds the search for the next occurrence, so that a rule which
+	// can never match cannot loop forever.
+	maxSteps = 1000
+)
+
+var ErrInvalidRule = errors.New("rrule: invalid rule")
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+type Rule struct {
+	Freq     Freq
+	Interval int
+	ByDay    []time.Weekday
+	Until    *time.Time
+	Count    int
+}
+
+// Parse parses a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=10".
+// An optional "RRULE:" prefix is accepted.
+func Parse(s string) (Rule, error) {
+	rule := Rule{Interval: 1}
+	seen := make(map[string]bool)
+
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+
+	if s == "" {
+		return Rule{}, fmt.Errorf("%w: empty rule", ErrInvalidRule)
+	}
+
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+
+		if !ok || value == "" {
+			return Rule{}, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+
+		key = strings.ToUpper(key)
+
+		if seen[key] {
+			return Rule{}, fmt.Errorf("%w: duplicate %s", ErrInvalidRule, key)
+		}
+
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			switch f := Freq(strings.ToUpper(value)); f {
+			case Daily, Weekly, Monthly:
+				rule.Freq = f
+			default:
+				return Rule{}, fmt.Errorf("%w: unsupported FREQ %q", ErrInvalidRule, value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+
+			if err != nil || n < 1 {
+				return Rule{}, fmt.Errorf("%w: INTERVAL must be a positive integer", ErrInvalidRule)
+			}
+
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+
+			if err != nil || n < 1 {
+				return Rule{}, fmt.Errorf("%w: COUNT must be a positive integer", ErrInvalidRule)
+			}
+
+			rule.Count = n
+		case "UNTIL":
+			t, err := time.Parse(untilDateTime, value)
+
+			if err != nil {
+				t, err = time.Parse(untilDate, value)
+			}
+
+			if err != nil {
+				return Rule{}, fmt.Errorf("%w: UNTIL must be YYYYMMDD or YYYYMMDDTHHMMSSZ", ErrInvalidRule)
+			}
+
+			rule.Until = &t
+		case "BYDAY":
+			for _, d := range strings.Split(strings.ToUpper(value), ",") {
+				wd, ok := weekdays[d]
+
+				if !ok {
+					return Rule{}, fmt.Errorf("%w: unsupported BYDAY value %q", ErrInvalidRule, d)
+				}
+
+				rule.ByDay = append(rule.ByDay, wd)
+			}
+		default:
+			return Rule{}, fmt.Errorf("%w: unsupported part %s", ErrInvalidRule, key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return Rule{}, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+
+	if rule.Count > 0 && rule.Until != nil {
+		return Rule{}, fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalidRule)
+	}
+
+	if rule.Freq == Monthly && len(rule.ByDay) > 0 {
+		return Rule{}, fmt.Errorf("%w: BYDAY is not supported with FREQ=MONTHLY", ErrInvalidRule)
+	}
+
+	return rule, nil
+}
+
+// Next returns the first occurrence strictly after the occurrence at from.
+// It reports false when the rule is exhausted by COUNT or UNTIL.
+func (r Rule) Next(from time.Time) (time.Time, bool) {
+	if r.Count == 1 {
+		return time.Time{}, false
+	}
+
+	var next time.Time
+
+	switch r.Freq {
+	case Daily:
+		next = r.nextDaily(from)
+	case Weekly:
+		next = r.nextWeekly(from)
+	case Monthly:
+		next = r.nextMonthly(from)
+	}
+
+	if next.IsZero() || (r.Until != nil && next.After(*r.Until)) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
+// Advance returns the rule that governs the occurrences following the current one.
+func (r Rule) Advance() Rule {
+	if r.Count > 0 {
+		r.Count--
+	}
+
+	return r
+}
+
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+
+		for _, wd := range r.ByDay {
+			days = append(days, strings.ToUpper(wd.String()[:2]))
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilDateTime))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+func (r Rule) matchesDay(t time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+
+	for _, wd := range r.ByDay {
+		if t.Weekday() == wd {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Rule) nextDaily(from time.Time) time.Time {
+	next := from
+
+	for i := 0; i < maxSteps; i++ {
+		next = next.AddDate(0, 0, r.Interval)
+
+		if r.matchesDay(next) {
+			return next
+		}
+	}
+
+	return time.Time{}
+}
+
+func (r Rule) nextWeekly(from time.Time) time.Time {
+	if len(r.ByDay) == 0 {
+		return from.AddDate(0, 0, 7*r.Interval)
+	}
+
+	// Remaining matching days in the current week (weeks start on Monday).
+	next := from
+
+	for next.AddDate(0, 0, 1).Weekday() != time.Monday {
+		next = next.AddDate(0, 0, 1)
+
+		if r.matchesDay(next) {
+			return next
+		}
+	}
+
+	// First matching day of the next week in the interval.
+	weekStart := from.AddDate(0, 0, -daysSinceMonday(from)+7*r.Interval)
+
+	for i := 0; i < 7; i++ {
+		if day := weekStart.AddDate(0, 0, i); r.matchesDay(day) {
+			return day
+		}
+	}
+
+	return time.Time{}
+}
+
+func (r Rule) nextMonthly(from time.Time) time.Time {
+	year, month, day := from.Date()
+
+	for i := 1; i < maxSteps; i++ {
+		next := time.Date(year, month+time.Month(i*r.Interval), day,
+			from.Hour(), from.Minute(), from.Second(), from.Nanosecond(), from.Location())
+
+		// Months without this day (e.g. the 31st) are skipped, as in RFC 5545.
+		if next.Day() == day {
+			return next
+		}
+	}
+
+	return time.Time{}
+}
+
+func daysSinceMonday(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
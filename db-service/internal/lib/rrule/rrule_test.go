@@ -0,0 +1,86 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	valid := []string{
+		"FREQ=DAILY",
+		"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+		"FREQ=MONTHLY;UNTIL=20261231",
+		"freq=weekly;count=3",
+	}
+
+	for _, s := range valid {
+		if _, err := Parse(s); err != nil {
+			t.Fatalf("Parse(%q): unexpected error %v", s, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=YEARLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=DAILY;COUNT=2;UNTIL=20261231",
+		"FREQ=MONTHLY;BYDAY=MO",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=WEEKLY;UNTIL=",
+	}
+
+	for _, s := range invalid {
+		if _, err := Parse(s); err == nil {
+			t.Fatalf("Parse(%q): expected an error", s)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	// 2026-10-14 is a Wednesday.
+	from := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		rule string
+		from time.Time
+		want time.Time
+		ok   bool
+	}{
+		{"FREQ=DAILY;INTERVAL=3", from, time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC), true},
+		{"FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC), time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC), true},
+		{"FREQ=WEEKLY", from, time.Date(2026, 10, 21, 9, 0, 0, 0, time.UTC), true},
+		{"FREQ=WEEKLY;BYDAY=MO,FR", from, time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC), true},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", from, time.Date(2026, 10, 26, 9, 0, 0, 0, time.UTC), true},
+		{"FREQ=MONTHLY", time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC), true},
+		{"FREQ=DAILY;COUNT=1", from, time.Time{}, false},
+		{"FREQ=WEEKLY;UNTIL=20261020", from, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		rule, err := Parse(tt.rule)
+
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.rule, err)
+		}
+
+		got, ok := rule.Next(tt.from)
+
+		if ok != tt.ok || !got.Equal(tt.want) {
+			t.Fatalf("%q.Next(%s) = %s, %v; want %s, %v", tt.rule, tt.from, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	rule, err := Parse("FREQ=WEEKLY;BYDAY=MO,TH;COUNT=3")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := rule.Advance().String(), "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=2"; got != want {
+		t.Fatalf("Advance() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,81 @@
+// Package search turns user search input into a PostgreSQL tsquery. Bare words must all
+// match, "quoted words" must match as a phrase and a word ending in * matches by prefix, so
+// `"weekly report" deploy*` becomes `'weekly' <-> 'report' & 'deploy':*`.
+package search
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+var ErrEmptyQuery = errors.New("search: empty query")
+
+// Parse compiles input into to_tsquery syntax. A word is a run of letters, digits and '_',
+// possibly joined by single hyphens; everything else separates words, so the result never carries tsquery operators from the input. An
+// unterminated quote runs to the end of the input.
+func Parse(input string) (string, error) {
+	var (
+		terms    []string
+		phrase   []string
+		inPhrase bool
+	)
+
+	closePhrase := func() {
+		if len(phrase) > 0 {
+			terms = append(terms, strings.Join(phrase, " <-> "))
+		}
+
+		phrase = nil
+	}
+
+	runes := []rune(input)
+
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case r == '"':
+			if inPhrase {
+				closePhrase()
+			}
+
+			inPhrase = !inPhrase
+			i++
+		case isWordRune(r):
+			j := i
+
+			for j < len(runes) && (isWordRune(runes[j]) ||
+				runes[j] == '-' && j+1 < len(runes) && isWordRune(runes[j+1])) {
+				j++
+			}
+
+			lexeme := "'" + string(runes[i:j]) + "'"
+
+			if j < len(runes) && runes[j] == '*' {
+				lexeme += ":*"
+				j++
+			}
+
+			if inPhrase {
+				phrase = append(phrase, lexeme)
+			} else {
+				terms = append(terms, lexeme)
+			}
+
+			i = j
+		default:
+			i++
+		}
+	}
+
+	closePhrase()
+
+	if len(terms) == 0 {
+		return "", ErrEmptyQuery
+	}
+
+	return strings.Join(terms, " & "), nil
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
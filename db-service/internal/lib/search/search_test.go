@@ -0,0 +1,35 @@
+package search
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := map[string]string{
+		"deploy":                   "'deploy'",
+		"deploy report":            "'deploy' & 'report'",
+		"depl*":                    "'depl':*",
+		`"weekly report" deploy*`:  "'weekly' <-> 'report' & 'deploy':*",
+		`"weekly rep*`:             "'weekly' <-> 'rep':*",
+		"отчёт за неделю":          "'отчёт' & 'за' & 'неделю'",
+		"a & b | !c:* <-> 'd' (e)": "'a' & 'b' & 'c' & 'd' & 'e'",
+		`"" word`:                  "'word'",
+		"e-mail -x--y":             "'e-mail' & 'x' & 'y'",
+	}
+
+	for in, want := range tests {
+		got, err := Parse(in)
+
+		if err != nil {
+			t.Fatalf("Parse(%q): unexpected error %v", in, err)
+		}
+
+		if got != want {
+			t.Fatalf("Parse(%q) = %q, want %q", in, got, want)
+		}
+	}
+
+	for _, in := range []string{"", "   ", `"" * &`} {
+		if _, err := Parse(in); err != ErrEmptyQuery {
+			t.Fatalf("Parse(%q): expected ErrEmptyQuery, got %v", in, err)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Package validate checks request fields against declarative rules. A Validator collects
+// every violation instead of stopping at the first, so a caller can report them all at once.
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Violation is a field that broke one of its rules.
+type Violation struct {
+	Field   string
+	Message string
+}
+
+// Rule checks a value and returns the violation message, or "" when the value passes.
+type Rule func(value string) string
+
+// Validator collects the violations of one request.
+type Validator struct {
+	violations []Violation
+}
+
+// String trims the whitespace around *value and checks what remains against rules in order.
+// Only the first rule the value breaks is reported.
+func (v *Validator) String(field string, value *string, rules ...Rule) {
+	*value = strings.TrimSpace(*value)
+
+	for _, rule := range rules {
+		if msg := rule(*value); msg != "" {
+			v.Add(field, msg)
+			return
+		}
+	}
+}
+
+// Check records a violation of field unless ok.
+func (v *Validator) Check(field string, ok bool, message string) {
+	if !ok {
+		v.Add(field, message)
+	}
+}
+
+// Add records a violation of field.
+func (v *Validator) Add(field, message string) {
+	v.violations = append(v.violations, Violation{Field: field, Message: message})
+}
+
+// Valid reports whether no violation was recorded.
+func (v *Validator) Valid() bool {
+	return len(v.violations) == 0
+}
+
+// Violations returns the recorded violations in the order they were found.
+func (v *Validator) Violations() []Violation {
+	return v.violations
+}
+
+// Required rejects an empty value.
+func Required(value string) string {
+	if value == "" {
+		return "is required"
+	}
+
+	return ""
+}
+
+// MaxLength rejects values longer than n characters.
+func MaxLength(n int) Rule {
+	return func(value string) string {
+		if utf8.RuneCountInString(value) > n {
+			return fmt.Sprintf("must be at most %d characters", n)
+		}
+
+		return ""
+	}
+}
+
+// SingleLine rejects invalid UTF-8 and any control character, line breaks included.
+func SingleLine(value string) string {
+	return control(value, false)
+}
+
+// MultiLine rejects invalid UTF-8 and control characters other than line breaks and tabs.
+func MultiLine(value string) string {
+	return control(value, true)
+}
+
+// OneOf rejects values that aren't listed.
+func OneOf(values ...string) Rule {
+	return func(value string) string {
+		for _, v := range values {
+			if value == v {
+				return ""
+			}
+		}
+
+		return "must be one of " + strings.Join(values, ", ")
+	}
+}
+
+func control(value string, multiLine bool) string {
+	if !utf8.ValidString(value) {
+		return "must be valid UTF-8"
+	}
+
+	for _, r := range value {
+		if multiLine && (r == '\n' || r == '\r' || r == '\t') {
+			continue
+		}
+
+		if unicode.IsControl(r) {
+			return "must not contain control characters"
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,64 @@
+package validate
+
+import "testing"
+
+func TestValidator(t *testing.T) {
+	var v Validator
+
+	title := "  Buy milk \t"
+	v.String("title", &title, Required, MaxLength(8), SingleLine)
+
+	if title != "Buy milk" || !v.Valid() {
+		t.Fatalf("String: expected a trimmed valid title, got %q %v", title, v.Violations())
+	}
+
+	empty, long, bell, notes := " ", "abcdefghij", "a\ab", "line\nline\tcell"
+	v.String("empty", &empty, Required, MaxLength(8))
+	v.String("long", &long, Required, MaxLength(8))
+	v.String("bell", &bell, SingleLine)
+	v.String("notes", &notes, MultiLine)
+	v.Check("priority", false, "is not a valid priority")
+
+	want := []Violation{
+		{"empty", "is required"},
+		{"long", "must be at most 8 characters"},
+		{"bell", "must not contain control characters"},
+		{"priority", "is not a valid priority"},
+	}
+
+	got := v.Violations()
+
+	if len(got) != len(want) {
+		t.Fatalf("Violations: expected %v, got %v", want, got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Violations[%d]: expected %v, got %v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestRules(t *testing.T) {
+	cases := []struct {
+		rule  Rule
+		value string
+		ok    bool
+	}{
+		{MaxLength(3), "ёжик", false},
+		{MaxLength(4), "ёжик", true},
+		{SingleLine, "a\nb", false},
+		{SingleLine, "a\u0085b", false},
+		{SingleLine, "\xff", false},
+		{MultiLine, "a\r\nb", true},
+		{MultiLine, "a\x00b", false},
+		{OneOf("low", "high"), "high", true},
+		{OneOf("low", "high"), "medium", false},
+	}
+
+	for _, c := range cases {
+		if ok := c.rule(c.value) == ""; ok != c.ok {
+			t.Fatalf("rule(%q): expected ok=%v", c.value, c.ok)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+	"todo/db/internal/domain/models"
+)
+
+var ErrIdempotencyMismatch = errors.New("service: idempotency key was already used for a different request")
+
+// requestHash fingerprints the fields of a create request, so a retry can be told apart from
+// a different request that reuses the same idempotency key.
+func requestHash(task models.Task) string {
+	var dueAt string
+
+	if task.DueAt != nil {
+		dueAt = task.DueAt.AsTime().UTC().Format(time.RFC3339Nano)
+	}
+
+	data, _ := json.Marshal([]any{
+		task.Title,
+		task.Description,
+		task.Priority,
+		dueAt,
+		task.ParentID,
+		task.Recurrence,
+		task.ProjectID,
+	})
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"testing"
+	"time"
+	"todo/db/internal/domain/models"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRequestHash(t *testing.T) {
+	due := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	base := models.Task{Title: "Deploy", Description: "Ship it", Priority: 2, DueAt: timestamppb.New(due)}
+
+	same := base
+	same.DueAt = timestamppb.New(due.In(time.FixedZone("MSK", 3*60*60)))
+
+	if requestHash(base) != requestHash(same) {
+		t.Fatal("requestHash: the same instant in another zone must hash the same")
+	}
+
+	tests := []func(*models.Task){
+		func(t *models.Task) { t.Title = "Deploy!" },
+		func(t *models.Task) { t.Description = "" },
+		func(t *models.Task) { t.Priority = 3 },
+		func(t *models.Task) { t.DueAt = nil },
+		func(t *models.Task) { t.ParentID = 1 },
+		func(t *models.Task) { t.Recurrence = "FREQ=DAILY" },
+		func(t *models.Task) { t.ProjectID = 1 },
+	}
+
+	for i, change := range tests {
+		other := base
+		change(&other)
+
+		if requestHash(other) == requestHash(base) {
+			t.Errorf("requestHash: change %d must change the hash", i)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"strconv"
+	"time"
+	"todo/db/internal/domain/models"
+)
+
+// sortFields lists the fields a task list can be sorted by.
+var sortFields = map[string]bool{
+	"created_at": true,
+	"title":      true,
+	"priority":   true,
+}
+
+// ValidSortField reports whether a task list can be sorted by field.
+func ValidSortField(field string) bool {
+	return sortFields[field]
+}
+
+// lookahead asks for one task more than the page holds, so that splitPage can tell whether another page follows.
+func lookahead(page models.Page) models.Page {
+	page.Size++
+
+	return page
+}
+
+// splitPage trims a lookahead result to the page size and returns the cursor of the next page, if there is one.
+func splitPage(tasks []models.Task, page models.Page) ([]models.Task, *models.Cursor) {
+	if len(tasks) <= page.Size {
+		return tasks, nil
+	}
+
+	tasks = tasks[:page.Size]
+	last := tasks[page.Size-1]
+
+	sort := page.Sort
+
+	if len(sort) == 0 {
+		sort = models.DefaultSort
+	}
+
+	cursor := &models.Cursor{
+		Values: make([]string, 0, len(sort)),
+		ID:     last.ID,
+	}
+
+	for _, key := range sort {
+		cursor.Values = append(cursor.Values, sortValue(last, key.Field))
+	}
+
+	return tasks, cursor
+}
+
+func sortValue(task models.Task, field string) string {
+	switch field {
+	case "title":
+		return task.Title
+	case "priority":
+		return strconv.Itoa(int(task.Priority))
+	default:
+		return task.CreatedAt.AsTime().Format(time.RFC3339Nano)
+	}
+}
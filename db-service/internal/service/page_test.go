@@ -0,0 +1,45 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+	"todo/db/internal/domain/models"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSplitPage(t *testing.T) {
+	created := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tasks := []models.Task{
+		{ID: 1, Title: "b", Priority: 2, CreatedAt: timestamppb.New(created)},
+		{ID: 2, Title: "a", Priority: 3, CreatedAt: timestamppb.New(created)},
+		{ID: 3, Title: "c", Priority: 1, CreatedAt: timestamppb.New(created.Add(time.Second))},
+	}
+
+	page, next := splitPage(tasks, models.Page{Size: 2})
+
+	if len(page) != 2 || next == nil {
+		t.Fatalf("splitPage(3 tasks, 2) = %d tasks, next %v; want 2 tasks and a cursor", len(page), next)
+	}
+
+	if want := []string{"2025-03-01T12:00:00Z"}; next.ID != 2 || !slices.Equal(next.Values, want) {
+		t.Fatalf("splitPage cursor = %+v, want id 2 at %v", next, want)
+	}
+
+	page, next = splitPage(tasks, models.Page{Size: 3})
+
+	if len(page) != 3 || next != nil {
+		t.Fatalf("splitPage(3 tasks, 3) = %d tasks, next %v; want 3 tasks and no cursor", len(page), next)
+	}
+
+	_, next = splitPage(tasks, models.Page{
+		Size: 2,
+		Sort: []models.SortKey{{Field: "priority", Desc: true}, {Field: "title"}},
+	})
+
+	if want := []string{"3", "a"}; next == nil || !slices.Equal(next.Values, want) {
+		t.Fatalf("splitPage cursor = %+v, want values %v", next, want)
+	}
+}
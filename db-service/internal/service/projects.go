@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"todo/db/internal/domain/models"
+	"todo/db/internal/lib/sl"
+)
+
+type ProjectProvider interface {
+	SaveProject(ctx context.Context, project models.Project) (int64, error)
+	GetProject(ctx context.Context, id int64) (models.Project, error)
+	UpdateProject(ctx context.Context, project models.Project) error
+	ArchiveProject(ctx context.Context, id int64) error
+	RemoveProject(ctx context.Context, id int64) ([]int64, error)
+	ListProjects(ctx context.Context, includeArchived bool) ([]models.Project, error)
+	ListProjectTasks(ctx context.Context, projectID int64) ([]models.Task, error)
+}
+
+type ProjectService struct {
+	log             *slog.Logger
+	projectProvider ProjectProvider
+	taskCache       TaskCache
+}
+
+func NewProjectService(
+	log *slog.Logger,
+	projectProvider ProjectProvider,
+	taskCache TaskCache,
+) *ProjectService {
+	return &ProjectService{
+		log:             log,
+		projectProvider: projectProvider,
+		taskCache:       taskCache,
+	}
+}
+
+func (s *ProjectService) CreateProject(ctx context.Context, project models.Project) (models.Project, error) {
+	const op = "service.CreateProject"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	id, err := s.projectProvider.SaveProject(ctx, project)
+
+	if err != nil {
+		log.Error("project not created", sl.Err(err))
+		return models.Project{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created, err := s.projectProvider.GetProject(ctx, id)
+
+	if err != nil {
+		log.Error("project not found", sl.Err(err))
+		return models.Project{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return created, nil
+}
+
+func (s *ProjectService) GetProject(ctx context.Context, id int64) (models.Project, error) {
+	const op = "service.GetProject"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	project, err := s.projectProvider.GetProject(ctx, id)
+
+	if err != nil {
+		log.Error("project not found", sl.Err(err))
+		return models.Project{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return project, nil
+}
+
+func (s *ProjectService) EditProject(ctx context.Context, project models.Project) error {
+	const op = "service.EditProject"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if err := s.projectProvider.UpdateProject(ctx, project); err != nil {
+		log.Error("project not updated", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteProject archives a project, or with cascade set deletes it and moves its tasks to the trash.
+func (s *ProjectService) DeleteProject(ctx context.Context, id int64, cascade bool) error {
+	const op = "service.DeleteProject"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if !cascade {
+		if err := s.projectProvider.ArchiveProject(ctx, id); err != nil {
+			log.Error("project not archived", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil
+	}
+
+	ids, err := s.projectProvider.RemoveProject(ctx, id)
+
+	if err != nil {
+		log.Error("project not deleted", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, taskID := range ids {
+		_ = s.taskCache.DelTask(ctx, taskID)
+	}
+
+	return nil
+}
+
+func (s *ProjectService) ListProjects(ctx context.Context, includeArchived bool) ([]models.Project, error) {
+	const op = "service.ListProjects"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	projects, err := s.projectProvider.ListProjects(ctx, includeArchived)
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return projects, nil
+}
+
+func (s *ProjectService) ListProjectTasks(ctx context.Context, id int64) ([]models.Task, error) {
+	const op = "service.ListProjectTasks"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if _, err := s.projectProvider.GetProject(ctx, id); err != nil {
+		log.Error("project not found", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tasks, err := s.projectProvider.ListProjectTasks(ctx, id)
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
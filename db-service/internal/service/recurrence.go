@@ -0,0 +1,46 @@
+package service
+
+import (
+	"time"
+	"todo/db/internal/domain/models"
+	"todo/db/internal/lib/rrule"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NextOccurrence builds the task that follows a completed occurrence of a recurring task.
+// The schedule is anchored on the due date, or on now for tasks without one. It returns nil
+// when the task does not recur or its rule is exhausted.
+func NextOccurrence(task models.Task, now time.Time) (*models.Task, error) {
+	if task.Recurrence == "" {
+		return nil, nil
+	}
+
+	rule, err := rrule.Parse(task.Recurrence)
+
+	if err != nil {
+		return nil, err
+	}
+
+	anchor := now
+
+	if task.DueAt != nil {
+		anchor = task.DueAt.AsTime()
+	}
+
+	due, ok := rule.Next(anchor)
+
+	if !ok {
+		return nil, nil
+	}
+
+	return &models.Task{
+		Title:       task.Title,
+		Description: task.Description,
+		Priority:    task.Priority,
+		DueAt:       timestamppb.New(due),
+		ParentID:    task.ParentID,
+		Recurrence:  rule.Advance().String(),
+		ProjectID:   task.ProjectID,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"testing"
+	"time"
+	"todo/db/internal/domain/models"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNextOccurrence(t *testing.T) {
+	due := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	task := models.Task{
+		Title:      "Standup",
+		Priority:   2,
+		DueAt:      timestamppb.New(due),
+		Recurrence: "FREQ=DAILY;COUNT=3",
+		ProjectID:  7,
+	}
+
+	next, err := NextOccurrence(task, due)
+
+	if err != nil || next == nil {
+		t.Fatalf("NextOccurrence: got %v, %v", next, err)
+	}
+
+	if next.ProjectID != task.ProjectID {
+		t.Fatalf("NextOccurrence: ProjectID = %d, want %d", next.ProjectID, task.ProjectID)
+	}
+
+	if !next.DueAt.AsTime().Equal(due.AddDate(0, 0, 1)) {
+		t.Fatalf("NextOccurrence: DueAt = %v, want %v", next.DueAt.AsTime(), due.AddDate(0, 0, 1))
+	}
+}
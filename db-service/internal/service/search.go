@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"todo/db/internal/domain/models"
+	"todo/db/internal/lib/search"
+	"todo/db/internal/lib/sl"
+)
+
+// DefaultSearchLanguage is the text search configuration used when a search doesn't pick one.
+const DefaultSearchLanguage = "english"
+
+var searchLanguages = map[string]bool{
+	"english": true,
+	"russian": true,
+}
+
+// ValidSearchLanguage reports whether tasks are indexed in the given text search configuration.
+func ValidSearchLanguage(language string) bool {
+	return searchLanguages[language]
+}
+
+// SearchTasks runs a full-text search over task titles and descriptions and returns up to
+// limit hits, best match first. An empty language means DefaultSearchLanguage.
+func (s *TaskService) SearchTasks(ctx context.Context, text, language string, limit int) ([]models.SearchHit, error) {
+	const op = "service.SearchTasks"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("language", language),
+	)
+
+	tsquery, err := search.Parse(text)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if language == "" {
+		language = DefaultSearchLanguage
+	}
+
+	hits, err := s.taskProvider.Search(ctx, language, tsquery, limit)
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return hits, nil
+}
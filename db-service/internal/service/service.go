@@ -2,23 +2,70 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"time"
 	"todo/db/internal/domain/models"
 	"todo/db/internal/lib/sl"
+	"todo/db/internal/storage"
 )
 
 type TaskProvider interface {
-	Save(ctx context.Context, title, description string) (int64, error)
+	Save(ctx context.Context, task models.Task) (int64, error)
+	SaveOnce(ctx context.Context, task models.Task, key, hash string) (models.Task, string, bool, error)
 	Get(ctx context.Context, id int64) (models.Task, error)
-	Update(ctx context.Context, id int64, title, description string) error
-	Remove(ctx context.Context, id int64) error
-	Complete(ctx context.Context, id int64) error
-	List(ctx context.Context) ([]models.Task, error)
-	ListCompleted(ctx context.Context) ([]models.Task, error)
-	ListNotCompleted(ctx context.Context) ([]models.Task, error)
+	Update(ctx context.Context, task models.Task) error
+	Patch(ctx context.Context, task models.Task, fields []string) error
+	Remove(ctx context.Context, id, version int64) ([]int64, error)
+	GetDeleted(ctx context.Context, id int64) (models.Task, error)
+	Restore(ctx context.Context, id int64) ([]int64, error)
+	Purge(ctx context.Context, id int64) error
+	PurgeDeletedBefore(ctx context.Context, t time.Time) (int64, error)
+	PurgeExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+	ListDeleted(ctx context.Context) ([]models.Task, error)
+	Archive(ctx context.Context, id int64) error
+	Unarchive(ctx context.Context, id int64) error
+	ArchiveCompletedBefore(ctx context.Context, t time.Time) ([]int64, error)
+	ListArchived(ctx context.Context) ([]models.Task, error)
+	Transition(ctx context.Context, id int64, from, to string, next *models.Task) error
+	Reopen(ctx context.Context, id int64) ([]int64, error)
+	List(ctx context.Context, filter models.TaskFilter, page models.Page) ([]models.Task, error)
+	Search(ctx context.Context, language, tsquery string, limit int) ([]models.SearchHit, error)
+	ListCompleted(ctx context.Context, page models.Page) ([]models.Task, error)
+	ListNotCompleted(ctx context.Context, page models.Page) ([]models.Task, error)
+	ListByPriority(ctx context.Context) ([]models.Task, error)
+	ListOverdue(ctx context.Context) ([]models.Task, error)
+	ListDueBetween(ctx context.Context, from, to time.Time) ([]models.Task, error)
+	ListByTags(ctx context.Context, tags []string, matchAll bool) ([]models.Task, error)
+	AttachTag(ctx context.Context, taskID int64, tag string) error
+	DetachTag(ctx context.Context, taskID int64, tag string) error
+	ListTags(ctx context.Context) ([]models.Tag, error)
+	ListSubtasks(ctx context.Context, id int64, recursive bool) ([]models.Task, error)
+	CountOpenSubtasks(ctx context.Context, id int64) (int64, error)
+	CompleteWithSubtasks(ctx context.Context, id int64, next *models.Task) ([]int64, error)
+	Move(ctx context.Context, id, parentID int64) error
+	AddDependency(ctx context.Context, taskID, blockedByID int64) error
+	RemoveDependency(ctx context.Context, taskID, blockedByID int64) error
+	ListBlockers(ctx context.Context, id int64) ([]models.Task, error)
+	CountOpenBlockers(ctx context.Context, id int64, withSubtasks bool) (int64, error)
+	ListReady(ctx context.Context) ([]models.Task, error)
+	GetProject(ctx context.Context, id int64) (models.Project, error)
 }
 
+var (
+	ErrOpenSubtasks    = errors.New("service: task has open subtasks")
+	ErrInvalidParent   = errors.New("service: invalid parent task")
+	ErrCycle           = errors.New("service: dependency would create a cycle")
+	ErrBlocked         = errors.New("service: task is blocked by open tasks")
+	ErrTransition      = errors.New("service: status transition not allowed")
+	ErrParentDeleted   = errors.New("service: parent task is in the trash")
+	ErrTaskOpen        = errors.New("service: task is still open")
+	ErrVersionMismatch = errors.New("service: task was modified by someone else")
+	ErrProjectNotFound = errors.New("service: project not found")
+)
+
 type TaskCache interface {
 	SetTask(ctx context.Context, task models.Task) error
 	GetTask(ctx context.Context, id int64) (models.Task, error)
@@ -43,27 +90,74 @@ func New(
 	}
 }
 
-func (s *TaskService) CreateTask(ctx context.Context, title, description string, completed bool) error {
+// CreateTask creates a task and returns it. With a non-empty idempotency key a retry of the same
+// request returns the task the first one created instead of a duplicate, and replayed is true.
+// Reusing the key for a different request fails with ErrIdempotencyMismatch. A parent that does
+// not exist fails with storage.ErrNotFound and one in the trash with ErrParentDeleted; a project
+// that does not exist fails with ErrProjectNotFound.
+func (s *TaskService) CreateTask(ctx context.Context, task models.Task, key string) (created models.Task, replayed bool, err error) {
 	const op = "service.CreateTask"
 
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
-	id, err := s.taskProvider.Save(ctx, title, description)
+	if task.ParentID != 0 {
+		if _, err := s.taskProvider.Get(ctx, task.ParentID); err != nil {
+			if _, derr := s.taskProvider.GetDeleted(ctx, task.ParentID); derr == nil {
+				log.Warn("parent is in the trash", slog.Int64("parent_id", task.ParentID))
+				return models.Task{}, false, fmt.Errorf("%s: %w", op, ErrParentDeleted)
+			}
+
+			log.Error("parent not found", sl.Err(err))
+			return models.Task{}, false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := s.checkProject(ctx, task.ProjectID); err != nil {
+		log.Warn("project not found", sl.Err(err))
+		return models.Task{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if key == "" {
+		id, err := s.taskProvider.Save(ctx, task)
+
+		if err != nil {
+			log.Error("task not created", sl.Err(err))
+			return models.Task{}, false, fmt.Errorf("%s: %w", op, err)
+		}
+
+		created, err = s.taskProvider.Get(ctx, id)
+
+		if err != nil {
+			log.Error("task not found", sl.Err(err))
+			return models.Task{}, false, fmt.Errorf("%s: %w", op, err)
+		}
+
+		_ = s.taskCache.SetTask(ctx, created)
+
+		return created, false, nil
+	}
+
+	hash := requestHash(task)
+
+	created, storedHash, isNew, err := s.taskProvider.SaveOnce(ctx, task, key, hash)
 
 	if err != nil {
 		log.Error("task not created", sl.Err(err))
-		return fmt.Errorf("%s: %w", op, err)
+		return models.Task{}, false, fmt.Errorf("%s: %w", op, err)
 	}
 
-	task, err := s.taskProvider.Get(ctx, id)
+	if storedHash != hash {
+		log.Warn("idempotency key reused for a different request")
+		return models.Task{}, false, fmt.Errorf("%s: %w", op, ErrIdempotencyMismatch)
+	}
 
-	if err == nil {
-		_ = s.taskCache.SetTask(ctx, task)
+	if isNew {
+		_ = s.taskCache.SetTask(ctx, created)
 	}
 
-	return nil
+	return created, !isNew, nil
 }
 
 func (s *TaskService) GetTask(ctx context.Context, id int64) (models.Task, error) {
@@ -85,15 +179,151 @@ func (s *TaskService) GetTask(ctx context.Context, id int64) (models.Task, error
 	return task, nil
 }
 
-func (s *TaskService) EditTask(ctx context.Context, id int64, title, description string) error {
+// EditTask overwrites a task and returns the updated task. Fields other than the title keep
+// their stored value when left empty.
+func (s *TaskService) EditTask(ctx context.Context, task models.Task) (models.Task, error) {
 	const op = "service.EditTask"
 
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
-	if err := s.taskProvider.Update(ctx, id, title, description); err != nil {
+	if err := s.checkProject(ctx, task.ProjectID); err != nil {
+		log.Warn("project not found", sl.Err(err))
+		return models.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.taskProvider.Update(ctx, task); err != nil {
+		log.Error("task not updated", sl.Err(err))
+		return models.Task{}, fmt.Errorf("%s: %w", op, s.checkVersion(ctx, task.ID, task.Version, err))
+	}
+
+	_ = s.taskCache.DelTask(ctx, task.ID)
+
+	updated, err := s.taskProvider.Get(ctx, task.ID)
+
+	if err != nil {
+		log.Error("task not found", sl.Err(err))
+		return models.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, nil
+}
+
+// UpdateTask writes only the listed fields of a task and returns the updated task.
+func (s *TaskService) UpdateTask(ctx context.Context, task models.Task, fields []string) (models.Task, error) {
+	const op = "service.UpdateTask"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Any("fields", fields),
+	)
+
+	if slices.Contains(fields, "project_id") {
+		if err := s.checkProject(ctx, task.ProjectID); err != nil {
+			log.Warn("project not found", sl.Err(err))
+			return models.Task{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := s.taskProvider.Patch(ctx, task, fields); err != nil {
 		log.Error("task not updated", sl.Err(err))
+		return models.Task{}, fmt.Errorf("%s: %w", op, s.checkVersion(ctx, task.ID, task.Version, err))
+	}
+
+	_ = s.taskCache.DelTask(ctx, task.ID)
+
+	updated, err := s.taskProvider.Get(ctx, task.ID)
+
+	if err != nil {
+		log.Error("task not found", sl.Err(err))
+		return models.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, nil
+}
+
+// checkProject fails with ErrProjectNotFound when projectID names a project that does not
+// exist. A zero projectID names no project.
+func (s *TaskService) checkProject(ctx context.Context, projectID int64) error {
+	if projectID == 0 {
+		return nil
+	}
+
+	_, err := s.taskProvider.GetProject(ctx, projectID)
+
+	if errors.Is(err, storage.ErrNotFound) {
+		return ErrProjectNotFound
+	}
+
+	return err
+}
+
+func (s *TaskService) DeleteTask(ctx context.Context, id, version int64) error {
+	const op = "service.DeleteTask"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	ids, err := s.taskProvider.Remove(ctx, id, version)
+
+	if err != nil {
+		log.Error("task not deleted", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, s.checkVersion(ctx, id, version, err))
+	}
+
+	for _, deleted := range ids {
+		_ = s.taskCache.DelTask(ctx, deleted)
+	}
+
+	return nil
+}
+
+// checkVersion explains a failed write that was conditioned on version: if the task still
+// exists under another version, someone else changed it first and ErrVersionMismatch is
+// returned instead of err.
+func (s *TaskService) checkVersion(ctx context.Context, id, version int64, err error) error {
+	if version == 0 {
+		return err
+	}
+
+	task, getErr := s.taskProvider.Get(ctx, id)
+
+	if getErr == nil && task.Version != version {
+		return ErrVersionMismatch
+	}
+
+	return err
+}
+
+// ArchiveTask hides a done or cancelled task from the default lists. Archiving an already
+// archived task is a no-op.
+func (s *TaskService) ArchiveTask(ctx context.Context, id int64) error {
+	const op = "service.ArchiveTask"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	task, err := s.taskProvider.Get(ctx, id)
+
+	if err != nil {
+		log.Error("task not found", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if isOpen(task.Status) {
+		log.Warn("open task cannot be archived", slog.String("status", task.Status))
+		return fmt.Errorf("%s: %w", op, ErrTaskOpen)
+	}
+
+	if task.ArchivedAt != nil {
+		return nil
+	}
+
+	if err := s.taskProvider.Archive(ctx, id); err != nil {
+		log.Error("task not archived", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -102,15 +332,15 @@ func (s *TaskService) EditTask(ctx context.Context, id int64, title, description
 	return nil
 }
 
-func (s *TaskService) DeleteTask(ctx context.Context, id int64) error {
-	const op = "service.DeleteTask"
+func (s *TaskService) UnarchiveTask(ctx context.Context, id int64) error {
+	const op = "service.UnarchiveTask"
 
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
-	if err := s.taskProvider.Remove(ctx, id); err != nil {
-		log.Error("task not deleted", sl.Err(err))
+	if err := s.taskProvider.Unarchive(ctx, id); err != nil {
+		log.Error("task not unarchived", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -119,31 +349,381 @@ func (s *TaskService) DeleteTask(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (s *TaskService) CompleteTask(ctx context.Context, id int64) error {
+// ArchiveCompletedBefore archives every task completed before the given time and returns how many were archived.
+func (s *TaskService) ArchiveCompletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	const op = "service.ArchiveCompletedBefore"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	ids, err := s.taskProvider.ArchiveCompletedBefore(ctx, before)
+
+	if err != nil {
+		log.Error("tasks not archived", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, archived := range ids {
+		_ = s.taskCache.DelTask(ctx, archived)
+	}
+
+	return int64(len(ids)), nil
+}
+
+func (s *TaskService) ListArchivedTasks(ctx context.Context) ([]models.Task, error) {
+	const op = "service.ListArchivedTasks"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	tasks, err := s.taskProvider.ListArchived(ctx)
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+func (s *TaskService) ListTrash(ctx context.Context) ([]models.Task, error) {
+	const op = "service.ListTrash"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	tasks, err := s.taskProvider.ListDeleted(ctx)
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+// RestoreTask takes a task out of the trash along with the subtasks deleted together with it.
+// A subtask whose parent is still in the trash cannot be restored on its own.
+func (s *TaskService) RestoreTask(ctx context.Context, id int64) error {
+	const op = "service.RestoreTask"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	task, err := s.taskProvider.GetDeleted(ctx, id)
+
+	if err != nil {
+		log.Error("task not found in trash", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if task.ParentID != 0 {
+		if _, err := s.taskProvider.GetDeleted(ctx, task.ParentID); err == nil {
+			log.Warn("parent is in the trash", slog.Int64("parent_id", task.ParentID))
+			return fmt.Errorf("%s: %w", op, ErrParentDeleted)
+		}
+	}
+
+	if _, err := s.taskProvider.Restore(ctx, id); err != nil {
+		log.Error("task not restored", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *TaskService) PurgeTask(ctx context.Context, id int64) error {
+	const op = "service.PurgeTask"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if err := s.taskProvider.Purge(ctx, id); err != nil {
+		log.Error("task not purged", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// PurgeTrash permanently deletes the tasks that were moved to the trash before the given time.
+func (s *TaskService) PurgeTrash(ctx context.Context, before time.Time) (int64, error) {
+	const op = "service.PurgeTrash"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	purged, err := s.taskProvider.PurgeDeletedBefore(ctx, before)
+
+	if err != nil {
+		log.Error("trash not purged", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return purged, nil
+}
+
+// PurgeIdempotencyKeys deletes the idempotency keys that can no longer be replayed.
+func (s *TaskService) PurgeIdempotencyKeys(ctx context.Context) (int64, error) {
+	const op = "service.PurgeIdempotencyKeys"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	purged, err := s.taskProvider.PurgeExpiredIdempotencyKeys(ctx)
+
+	if err != nil {
+		log.Error("idempotency keys not purged", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return purged, nil
+}
+
+// CompleteTask moves an open task straight to done, skipping the intermediate statuses;
+// it is kept for clients that only know about the completed flag. A task with open subtasks
+// is rejected unless cascade is set, in which case the whole subtree is completed together
+// with it. Tasks with open blockers outside of the completed subtree are always rejected.
+// Completing a recurring task creates its next occurrence atomically.
+func (s *TaskService) CompleteTask(ctx context.Context, id int64, cascade bool) (models.Task, error) {
 	const op = "service.CompleteTask"
 
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
-	if err := s.taskProvider.Complete(ctx, id); err != nil {
+	task, err := s.taskProvider.Get(ctx, id)
+
+	if err != nil {
+		log.Error("task not found", sl.Err(err))
+		return models.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !isOpen(task.Status) {
+		log.Warn("task is not open", slog.String("status", task.Status))
+		return models.Task{}, fmt.Errorf("%s: %w", op, ErrTransition)
+	}
+
+	if err := s.complete(ctx, task, cascade); err != nil {
 		log.Error("task not completed", sl.Err(err))
+		return models.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	completed, err := s.taskProvider.Get(ctx, id)
+
+	if err != nil {
+		log.Error("task not found", sl.Err(err))
+		return models.Task{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return completed, nil
+}
+
+// TransitionTask moves a task to the given status if the workflow allows it.
+func (s *TaskService) TransitionTask(ctx context.Context, id int64, status string) error {
+	const op = "service.TransitionTask"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	task, err := s.taskProvider.Get(ctx, id)
+
+	if err != nil {
+		log.Error("task not found", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !canTransition(task.Status, status) {
+		log.Warn("transition not allowed", slog.String("from", task.Status), slog.String("to", status))
+		return fmt.Errorf("%s: %w", op, ErrTransition)
+	}
+
+	if status == models.StatusDone {
+		err = s.complete(ctx, task, false)
+	} else {
+		err = s.taskProvider.Transition(ctx, id, task.Status, status, nil)
+	}
+
+	if err != nil {
+		log.Error("task not transitioned", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_ = s.taskCache.DelTask(ctx, id)
+
+	return nil
+}
+
+// ReopenTask moves a done or cancelled task back to todo. The next occurrence completing a
+// recurring task spawned is dropped again while it is untouched.
+func (s *TaskService) ReopenTask(ctx context.Context, id int64) error {
+	const op = "service.ReopenTask"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	task, err := s.taskProvider.Get(ctx, id)
+
+	if err != nil {
+		log.Error("task not found", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if isOpen(task.Status) {
+		log.Warn("task is already open", slog.String("status", task.Status))
+		return fmt.Errorf("%s: %w", op, ErrTransition)
+	}
+
+	trashed, err := s.taskProvider.Reopen(ctx, id)
+
+	if err != nil {
+		log.Error("task not reopened", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	_ = s.taskCache.DelTask(ctx, id)
 
+	for _, occurrence := range trashed {
+		_ = s.taskCache.DelTask(ctx, occurrence)
+	}
+
+	return nil
+}
+
+// complete moves task to done after checking its blockers and subtasks. With cascade set,
+// the open subtasks are completed along with it instead of failing the call.
+func (s *TaskService) complete(ctx context.Context, task models.Task, cascade bool) error {
+	log := s.log.With(
+		slog.String("op", "service.complete"),
+	)
+
+	next, err := NextOccurrence(task, time.Now())
+
+	if err != nil {
+		log.Warn("invalid recurrence, next occurrence skipped", sl.Err(err))
+	}
+
+	blockers, err := s.taskProvider.CountOpenBlockers(ctx, task.ID, cascade)
+
+	if err != nil {
+		return err
+	}
+
+	if blockers > 0 {
+		log.Warn("task is blocked", slog.Int64("blockers", blockers))
+		return ErrBlocked
+	}
+
+	if cascade {
+		ids, err := s.taskProvider.CompleteWithSubtasks(ctx, task.ID, next)
+
+		if err != nil {
+			return err
+		}
+
+		for _, completed := range ids {
+			_ = s.taskCache.DelTask(ctx, completed)
+		}
+
+		return nil
+	}
+
+	open, err := s.taskProvider.CountOpenSubtasks(ctx, task.ID)
+
+	if err != nil {
+		return err
+	}
+
+	if open > 0 {
+		log.Warn("task has open subtasks", slog.Int64("open", open))
+		return ErrOpenSubtasks
+	}
+
+	if err := s.taskProvider.Transition(ctx, task.ID, task.Status, models.StatusDone, next); err != nil {
+		return err
+	}
+
+	_ = s.taskCache.DelTask(ctx, task.ID)
+
 	return nil
 }
 
-func (s *TaskService) ListTasks(ctx context.Context) ([]models.Task, error) {
+// ListTasks returns one page of the tasks that match the filter and the cursor of the next page,
+// which is nil on the last page.
+func (s *TaskService) ListTasks(ctx context.Context, filter models.TaskFilter, page models.Page) ([]models.Task, *models.Cursor, error) {
 	const op = "service.ListTasks"
 
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
-	tasks, err := s.taskProvider.List(ctx)
+	tasks, err := s.taskProvider.List(ctx, filter, lookahead(page))
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tasks, next := splitPage(tasks, page)
+
+	return tasks, next, nil
+}
+
+func (s *TaskService) ListCompletedTasks(ctx context.Context, page models.Page) ([]models.Task, *models.Cursor, error) {
+	const op = "service.ListCompletedTasks"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	tasks, err := s.taskProvider.ListCompleted(ctx, lookahead(page))
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tasks, next := splitPage(tasks, page)
+
+	return tasks, next, nil
+}
+
+func (s *TaskService) ListNotCompletedTasks(ctx context.Context, page models.Page) ([]models.Task, *models.Cursor, error) {
+	const op = "service.ListNotCompletedTasks"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	tasks, err := s.taskProvider.ListNotCompleted(ctx, lookahead(page))
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tasks, next := splitPage(tasks, page)
+
+	return tasks, next, nil
+}
+
+func (s *TaskService) ListTasksByPriority(ctx context.Context) ([]models.Task, error) {
+	const op = "service.ListTasksByPriority"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	tasks, err := s.taskProvider.ListByPriority(ctx)
 
 	if err != nil {
 		log.Error("internal error", sl.Err(err))
@@ -153,14 +733,14 @@ func (s *TaskService) ListTasks(ctx context.Context) ([]models.Task, error) {
 	return tasks, nil
 }
 
-func (s *TaskService) ListCompletedTasks(ctx context.Context) ([]models.Task, error) {
-	const op = "service.ListCompletedTasks"
+func (s *TaskService) ListOverdueTasks(ctx context.Context) ([]models.Task, error) {
+	const op = "service.ListOverdueTasks"
 
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
-	tasks, err := s.taskProvider.ListCompleted(ctx)
+	tasks, err := s.taskProvider.ListOverdue(ctx)
 
 	if err != nil {
 		log.Error("internal error", sl.Err(err))
@@ -170,14 +750,214 @@ func (s *TaskService) ListCompletedTasks(ctx context.Context) ([]models.Task, er
 	return tasks, nil
 }
 
-func (s *TaskService) ListNotCompletedTasks(ctx context.Context) ([]models.Task, error) {
-	const op = "service.ListNotCompletedTasks"
+func (s *TaskService) ListDueBetween(ctx context.Context, from, to time.Time) ([]models.Task, error) {
+	const op = "service.ListDueBetween"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	tasks, err := s.taskProvider.ListDueBetween(ctx, from, to)
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+func (s *TaskService) ListTasksByTags(ctx context.Context, tags []string, matchAll bool) ([]models.Task, error) {
+	const op = "service.ListTasksByTags"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	tasks, err := s.taskProvider.ListByTags(ctx, uniqueTags(tags), matchAll)
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+func (s *TaskService) AttachTag(ctx context.Context, taskID int64, tag string) error {
+	const op = "service.AttachTag"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if err := s.taskProvider.AttachTag(ctx, taskID, tag); err != nil {
+		log.Error("tag not attached", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_ = s.taskCache.DelTask(ctx, taskID)
+
+	return nil
+}
+
+func (s *TaskService) DetachTag(ctx context.Context, taskID int64, tag string) error {
+	const op = "service.DetachTag"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if err := s.taskProvider.DetachTag(ctx, taskID, tag); err != nil {
+		log.Error("tag not detached", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_ = s.taskCache.DelTask(ctx, taskID)
+
+	return nil
+}
+
+func (s *TaskService) ListTags(ctx context.Context) ([]models.Tag, error) {
+	const op = "service.ListTags"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	tags, err := s.taskProvider.ListTags(ctx)
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tags, nil
+}
+
+func (s *TaskService) ListSubtasks(ctx context.Context, id int64, recursive bool) ([]models.Task, error) {
+	const op = "service.ListSubtasks"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if _, err := s.taskProvider.Get(ctx, id); err != nil {
+		log.Error("task not found", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tasks, err := s.taskProvider.ListSubtasks(ctx, id, recursive)
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+// MoveTask re-parents a task. A zero parentID moves it to the top level; moving a task
+// under itself or one of its own descendants is rejected with ErrInvalidParent.
+func (s *TaskService) MoveTask(ctx context.Context, id, parentID int64) error {
+	const op = "service.MoveTask"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if parentID == id {
+		return fmt.Errorf("%s: %w", op, ErrInvalidParent)
+	}
+
+	err := s.taskProvider.Move(ctx, id, parentID)
+
+	if errors.Is(err, storage.ErrCycle) {
+		log.Warn("move would create a cycle", slog.Int64("parent_id", parentID))
+		return fmt.Errorf("%s: %w", op, ErrInvalidParent)
+	}
+
+	if err != nil {
+		log.Error("task not moved", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_ = s.taskCache.DelTask(ctx, id)
+
+	return nil
+}
+
+// AddDependency records that taskID is blocked by blockedByID, rejecting edges that would close a cycle.
+func (s *TaskService) AddDependency(ctx context.Context, taskID, blockedByID int64) error {
+	const op = "service.AddDependency"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	err := s.taskProvider.AddDependency(ctx, taskID, blockedByID)
+
+	if errors.Is(err, storage.ErrCycle) {
+		log.Warn("dependency would create a cycle",
+			slog.Int64("task_id", taskID),
+			slog.Int64("blocked_by_id", blockedByID),
+		)
+		return fmt.Errorf("%s: %w", op, ErrCycle)
+	}
+
+	if err != nil {
+		log.Error("dependency not added", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *TaskService) RemoveDependency(ctx context.Context, taskID, blockedByID int64) error {
+	const op = "service.RemoveDependency"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if err := s.taskProvider.RemoveDependency(ctx, taskID, blockedByID); err != nil {
+		log.Error("dependency not removed", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *TaskService) ListBlockers(ctx context.Context, id int64) ([]models.Task, error) {
+	const op = "service.ListBlockers"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if _, err := s.taskProvider.Get(ctx, id); err != nil {
+		log.Error("task not found", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tasks, err := s.taskProvider.ListBlockers(ctx, id)
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tasks, nil
+}
+
+func (s *TaskService) ListReadyTasks(ctx context.Context) ([]models.Task, error) {
+	const op = "service.ListReadyTasks"
 
 	log := s.log.With(
 		slog.String("op", op),
 	)
 
-	tasks, err := s.taskProvider.ListNotCompleted(ctx)
+	tasks, err := s.taskProvider.ListReady(ctx)
 
 	if err != nil {
 		log.Error("internal error", sl.Err(err))
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"todo/db/internal/domain/models"
+	"todo/db/internal/storage"
+)
+
+// refProvider knows live and trashed tasks and projects by id; every other TaskProvider
+// method panics, and a write only records that it happened.
+type refProvider struct {
+	TaskProvider
+	live, deleted, projects map[int64]bool
+	written                 bool
+}
+
+func (p *refProvider) Get(_ context.Context, id int64) (models.Task, error) {
+	if !p.live[id] {
+		return models.Task{}, storage.ErrNotFound
+	}
+
+	return models.Task{ID: id}, nil
+}
+
+func (p *refProvider) GetDeleted(_ context.Context, id int64) (models.Task, error) {
+	if !p.deleted[id] {
+		return models.Task{}, storage.ErrNotFound
+	}
+
+	return models.Task{ID: id}, nil
+}
+
+func (p *refProvider) GetProject(_ context.Context, id int64) (models.Project, error) {
+	if !p.projects[id] {
+		return models.Project{}, storage.ErrNotFound
+	}
+
+	return models.Project{ID: id}, nil
+}
+
+func (p *refProvider) Save(_ context.Context, _ models.Task) (int64, error) {
+	p.written = true
+	return 0, errors.New("saved")
+}
+
+func (p *refProvider) Update(_ context.Context, _ models.Task) error {
+	p.written = true
+	return errors.New("updated")
+}
+
+func (p *refProvider) Patch(_ context.Context, _ models.Task, _ []string) error {
+	p.written = true
+	return errors.New("patched")
+}
+
+func TestTaskReferences(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(*TaskService) error
+		want error
+	}{
+		{"create under a missing parent", func(s *TaskService) error {
+			_, _, err := s.CreateTask(context.Background(), models.Task{Title: "Sub", ParentID: 7}, "")
+			return err
+		}, storage.ErrNotFound},
+		{"create under a trashed parent", func(s *TaskService) error {
+			_, _, err := s.CreateTask(context.Background(), models.Task{Title: "Sub", ParentID: 8}, "")
+			return err
+		}, ErrParentDeleted},
+		{"create in a missing project", func(s *TaskService) error {
+			_, _, err := s.CreateTask(context.Background(), models.Task{Title: "Task", ProjectID: 9}, "")
+			return err
+		}, ErrProjectNotFound},
+		{"edit into a missing project", func(s *TaskService) error {
+			_, err := s.EditTask(context.Background(), models.Task{ID: 1, Title: "Task", ProjectID: 9})
+			return err
+		}, ErrProjectNotFound},
+		{"patch into a missing project", func(s *TaskService) error {
+			_, err := s.UpdateTask(context.Background(), models.Task{ID: 1, ProjectID: 9}, []string{"project_id"})
+			return err
+		}, ErrProjectNotFound},
+	}
+
+	for _, tt := range tests {
+		provider := &refProvider{deleted: map[int64]bool{8: true}}
+		s := New(slog.New(slog.NewTextHandler(io.Discard, nil)), provider, nil)
+
+		if err := tt.call(s); !errors.Is(err, tt.want) {
+			t.Errorf("%s: error %v, want %v", tt.name, err, tt.want)
+		}
+
+		if provider.written {
+			t.Errorf("%s: nothing must be written", tt.name)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import "todo/db/internal/domain/models"
+
+// transitions lists the statuses a task may move to from each status.
+var transitions = map[string][]string{
+	models.StatusTodo:       {models.StatusInProgress, models.StatusCancelled},
+	models.StatusInProgress: {models.StatusTodo, models.StatusReview, models.StatusCancelled},
+	models.StatusReview:     {models.StatusInProgress, models.StatusDone, models.StatusCancelled},
+	models.StatusCancelled:  {models.StatusTodo},
+}
+
+// ValidStatus reports whether status is one of the known task statuses.
+func ValidStatus(status string) bool {
+	switch status {
+	case models.StatusTodo, models.StatusInProgress, models.StatusReview, models.StatusDone, models.StatusCancelled:
+		return true
+	}
+
+	return false
+}
+
+func canTransition(from, to string) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isOpen reports whether a task in the given status still counts as outstanding work.
+func isOpen(status string) bool {
+	return status != models.StatusDone && status != models.StatusCancelled
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"testing"
+	"todo/db/internal/domain/models"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want bool
+	}{
+		{"start work", models.StatusTodo, models.StatusInProgress, true},
+		{"send to review", models.StatusInProgress, models.StatusReview, true},
+		{"approve", models.StatusReview, models.StatusDone, true},
+		{"request changes", models.StatusReview, models.StatusInProgress, true},
+		{"cancel", models.StatusInProgress, models.StatusCancelled, true},
+		{"restore cancelled", models.StatusCancelled, models.StatusTodo, true},
+		{"skip review", models.StatusInProgress, models.StatusDone, false},
+		{"skip work", models.StatusTodo, models.StatusReview, false},
+		{"leave done", models.StatusDone, models.StatusTodo, false},
+		{"same status", models.StatusTodo, models.StatusTodo, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canTransition(tt.from, tt.to); got != tt.want {
+				t.Fatalf("canTransition(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
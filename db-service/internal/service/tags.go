@@ -0,0 +1,12 @@
+package service
+
+import "slices"
+
+// uniqueTags returns the tags sorted and without repeats. With match=all the storage compares
+// the number of matched tags with the number asked for, so a repeated tag would match nothing.
+func uniqueTags(tags []string) []string {
+	unique := slices.Clone(tags)
+	slices.Sort(unique)
+
+	return slices.Compact(unique)
+}
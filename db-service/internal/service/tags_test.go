@@ -0,0 +1,18 @@
+package service
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUniqueTags(t *testing.T) {
+	tags := []string{"bug", "backend", "bug"}
+
+	if got := uniqueTags(tags); !slices.Equal(got, []string{"backend", "bug"}) {
+		t.Fatalf("uniqueTags(%v) = %v, want [backend bug]", tags, got)
+	}
+
+	if !slices.Equal(tags, []string{"bug", "backend", "bug"}) {
+		t.Fatalf("uniqueTags modified its argument: %v", tags)
+	}
+}
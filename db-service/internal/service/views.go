@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"todo/db/internal/domain/models"
+	"todo/db/internal/lib/filter"
+	"todo/db/internal/lib/sl"
+	"todo/db/internal/storage"
+)
+
+var ErrViewExists = errors.New("service: owner already has a view with this name")
+
+type ViewProvider interface {
+	SaveView(ctx context.Context, view models.SavedView) (int64, error)
+	GetView(ctx context.Context, id int64, owner string) (models.SavedView, error)
+	UpdateView(ctx context.Context, view models.SavedView) error
+	RemoveView(ctx context.Context, id int64, owner string) error
+	ListViews(ctx context.Context, owner string) ([]models.SavedView, error)
+}
+
+type TaskLister interface {
+	List(ctx context.Context, filter models.TaskFilter, page models.Page) ([]models.Task, error)
+}
+
+type ViewService struct {
+	log          *slog.Logger
+	viewProvider ViewProvider
+	taskLister   TaskLister
+}
+
+func NewViewService(
+	log *slog.Logger,
+	viewProvider ViewProvider,
+	taskLister TaskLister,
+) *ViewService {
+	return &ViewService{
+		log:          log,
+		viewProvider: viewProvider,
+		taskLister:   taskLister,
+	}
+}
+
+func (s *ViewService) CreateView(ctx context.Context, view models.SavedView) (models.SavedView, error) {
+	const op = "service.CreateView"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("owner", view.Owner),
+	)
+
+	id, err := s.viewProvider.SaveView(ctx, view)
+
+	if errors.Is(err, storage.ErrConflict) {
+		log.Warn("view not created", sl.Err(err))
+		return models.SavedView{}, fmt.Errorf("%s: %w", op, ErrViewExists)
+	}
+
+	if err != nil {
+		log.Error("view not created", sl.Err(err))
+		return models.SavedView{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created, err := s.viewProvider.GetView(ctx, id, view.Owner)
+
+	if err != nil {
+		log.Error("view not found", sl.Err(err))
+		return models.SavedView{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return created, nil
+}
+
+// GetView returns one of the owner's views.
+func (s *ViewService) GetView(ctx context.Context, id int64, owner string) (models.SavedView, error) {
+	const op = "service.GetView"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	view, err := s.viewProvider.GetView(ctx, id, owner)
+
+	if err != nil {
+		log.Error("view not found", sl.Err(err))
+		return models.SavedView{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return view, nil
+}
+
+// EditView replaces the name and criteria of one of view.Owner's views. The owner can't change.
+func (s *ViewService) EditView(ctx context.Context, view models.SavedView) error {
+	const op = "service.EditView"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	err := s.viewProvider.UpdateView(ctx, view)
+
+	if errors.Is(err, storage.ErrConflict) {
+		log.Warn("view not updated", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, ErrViewExists)
+	}
+
+	if err != nil {
+		log.Error("view not updated", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteView deletes one of the owner's views.
+func (s *ViewService) DeleteView(ctx context.Context, id int64, owner string) error {
+	const op = "service.DeleteView"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	if err := s.viewProvider.RemoveView(ctx, id, owner); err != nil {
+		log.Error("view not deleted", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *ViewService) ListViews(ctx context.Context, owner string) ([]models.SavedView, error) {
+	const op = "service.ListViews"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("owner", owner),
+	)
+
+	views, err := s.viewProvider.ListViews(ctx, owner)
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return views, nil
+}
+
+// ListViewTasks runs the view's criteria and returns one page of matching tasks and the cursor
+// of the next page. The page must be in the view's sort order.
+func (s *ViewService) ListViewTasks(ctx context.Context, view models.SavedView, page models.Page) ([]models.Task, *models.Cursor, error) {
+	const op = "service.ListViewTasks"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int64("view", view.ID),
+	)
+
+	criteria := view.Filter
+
+	if criteria.Expression != "" {
+		expr, err := filter.Parse(criteria.Expression)
+
+		if err != nil {
+			log.Error("stored filter is invalid", sl.Err(err))
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		criteria.Expr = expr
+	}
+
+	tasks, err := s.taskLister.List(ctx, criteria, lookahead(page))
+
+	if err != nil {
+		log.Error("internal error", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tasks, next := splitPage(tasks, page)
+
+	return tasks, next, nil
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+	"todo/db/internal/lib/filter"
+)
+
+// filterColumns maps the fields of the filter language to the SQL they compare.
+var filterColumns = map[string]string{
+	"title":       "title",
+	"description": "coalesce(description, '')",
+	"status":      "status",
+	"priority":    "priority",
+	"created":     "created_at",
+	"due":         "due_at",
+}
+
+var sqlOps = map[filter.Op]string{
+	filter.Eq: "=",
+	filter.Ne: "<>",
+	filter.Lt: "<",
+	filter.Le: "<=",
+	filter.Gt: ">",
+	filter.Ge: ">=",
+}
+
+// compileFilter turns a parsed filter into a SQL condition over tasks, appending its values to
+// args. Relative times resolve against now.
+func compileFilter(e filter.Expr, now time.Time, args []any) (string, []any) {
+	switch e := e.(type) {
+	case *filter.And:
+		left, args := compileFilter(e.Left, now, args)
+		right, args := compileFilter(e.Right, now, args)
+
+		return "(" + left + " AND " + right + ")", args
+	case *filter.Or:
+		left, args := compileFilter(e.Left, now, args)
+		right, args := compileFilter(e.Right, now, args)
+
+		return "(" + left + " OR " + right + ")", args
+	case *filter.Not:
+		x, args := compileFilter(e.X, now, args)
+
+		return "(NOT " + x + ")", args
+	default:
+		return compileCmp(e.(*filter.Cmp), now, args)
+	}
+}
+
+func compileCmp(c *filter.Cmp, now time.Time, args []any) (string, []any) {
+	var cond string
+
+	switch c.Kind {
+	case filter.Text:
+		if c.Op == filter.Contains {
+			args = append(args, "%"+likeEscaper.Replace(c.Text)+"%")
+			return fmt.Sprintf("%s ILIKE $%d", filterColumns[c.Field], len(args)), args
+		}
+
+		args = append(args, c.Text)
+		cond = fmt.Sprintf("lower(%s) = lower($%d)", filterColumns[c.Field], len(args))
+	case filter.Tag:
+		args = append(args, c.Text)
+		cond = fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM task_tags tt JOIN tags tg ON tg.id = tt.tag_id
+			WHERE tt.task_id = tasks.id AND tg.name = $%d
+		)`, len(args))
+	case filter.Bool:
+		// completed!=true means completed:false, not "anything but done": a cancelled task is
+		// neither completed nor open, so negating the condition would let it through.
+		if c.Bool != (c.Op == filter.Ne) {
+			return "(status = 'done')", args
+		}
+
+		return "(status NOT IN ('done', 'cancelled'))", args
+	case filter.Status:
+		args = append(args, c.Text)
+		return fmt.Sprintf("status %s $%d", sqlOps[c.Op], len(args)), args
+	case filter.Priority:
+		args = append(args, c.Rank)
+		return fmt.Sprintf("priority %s $%d", sqlOps[c.Op], len(args)), args
+	case filter.Time:
+		args = append(args, c.At(now))
+		return fmt.Sprintf("%s %s $%d", filterColumns[c.Field], sqlOps[c.Op], len(args)), args
+	}
+
+	if c.Op == filter.Ne {
+		cond = "NOT " + cond
+	}
+
+	return "(" + cond + ")", args
+}
@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+	"todo/db/internal/lib/filter"
+)
+
+func TestCompileCmpCompleted(t *testing.T) {
+	compile := func(op filter.Op, value bool) string {
+		cond, _ := compileCmp(&filter.Cmp{Field: "completed", Kind: filter.Bool, Op: op, Bool: value}, time.Now(), nil)
+		return cond
+	}
+
+	if got, want := compile(filter.Ne, true), compile(filter.Eq, false); got != want {
+		t.Errorf("completed!=true = %q, want the completed:false condition %q", got, want)
+	}
+
+	if got, want := compile(filter.Ne, false), compile(filter.Eq, true); got != want {
+		t.Errorf("completed!=false = %q, want the completed:true condition %q", got, want)
+	}
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"todo/db/internal/domain/models"
+)
+
+// idempotencyWindow is how long a key is replayed; older keys are reused as new ones until
+// PurgeExpiredIdempotencyKeys removes them.
+const idempotencyWindow = "24 hours"
+
+// SaveOnce creates a task under an idempotency key. The first request with a key creates the
+// task and stores hash together with a snapshot of the created task. A repeated key within
+// idempotencyWindow creates nothing: it returns the stored snapshot and hash with created set to
+// false, so the caller can tell a replay from a reused key.
+func (s *PGStorage) SaveOnce(ctx context.Context, task models.Task, key, hash string) (models.Task, string, bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return models.Task{}, "", false, ErrInternal
+	}
+
+	defer tx.Rollback()
+
+	// A concurrent request with the same key blocks here until the first one commits. An
+	// expired key is taken over as if it were new.
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO idempotency_keys (key, request_hash) VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE SET request_hash = EXCLUDED.request_hash, response = NULL, created_at = NOW()
+		WHERE idempotency_keys.created_at < NOW() - INTERVAL '`+idempotencyWindow+`'
+	`, key, hash)
+
+	if err != nil {
+		return models.Task{}, "", false, ErrInternal
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return models.Task{}, "", false, ErrInternal
+	}
+
+	if rows == 0 {
+		var (
+			storedHash string
+			response   []byte
+			stored     models.Task
+		)
+
+		if err := tx.QueryRowContext(ctx,
+			`SELECT request_hash, response FROM idempotency_keys WHERE key = $1`, key,
+		).Scan(&storedHash, &response); err != nil {
+			return models.Task{}, "", false, ErrInternal
+		}
+
+		if err := json.Unmarshal(response, &stored); err != nil {
+			return models.Task{}, "", false, ErrInternal
+		}
+
+		if err := tx.Commit(); err != nil {
+			return models.Task{}, "", false, ErrInternal
+		}
+
+		return stored, storedHash, false, nil
+	}
+
+	created, err := insertTask(ctx, tx, task)
+
+	if err != nil {
+		return models.Task{}, "", false, err
+	}
+
+	response, err := json.Marshal(created)
+
+	if err != nil {
+		return models.Task{}, "", false, ErrInternal
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE idempotency_keys SET response = $2 WHERE key = $1`, key, response,
+	); err != nil {
+		return models.Task{}, "", false, ErrInternal
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Task{}, "", false, ErrInternal
+	}
+
+	return created, hash, true, nil
+}
+
+// PurgeExpiredIdempotencyKeys deletes the keys older than idempotencyWindow and returns how many
+// were removed.
+func (s *PGStorage) PurgeExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	query := `DELETE FROM idempotency_keys WHERE created_at < NOW() - INTERVAL '` + idempotencyWindow + `'`
+
+	res, err := s.db.ExecContext(ctx, query)
+
+	if err != nil {
+		return 0, ErrInternal
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return 0, ErrInternal
+	}
+
+	return rows, nil
+}
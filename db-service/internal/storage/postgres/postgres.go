@@ -5,13 +5,36 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 	"todo/db/internal/domain/models"
+	"todo/db/internal/storage"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const taskColumns = `
+	id, title, description, status, status_changed_at, priority, created_at, completed_at, due_at, parent_id, recurrence, project_id, deleted_at, archived_at, version,
+	(
+		SELECT string_agg(tg.name, ',' ORDER BY tg.name)
+		FROM task_tags tt
+		JOIN tags tg ON tg.id = tt.tag_id
+		WHERE tt.task_id = tasks.id
+	) AS tags`
+
+// Keys of the transaction-level advisory locks held by writers of the dependency graph and of
+// the subtask tree, so a cycle check and the write after it see the same graph.
+const (
+	dependencyLock = 1
+	hierarchyLock  = 2
 )
 
 var (
-	ErrNotFound = errors.New("postgres: not found")
-	ErrConflict = errors.New("postgres: conflict")
-	ErrInternal = errors.New("postgres: internal error")
+	ErrNotFound  = storage.ErrNotFound
+	ErrConflict  = storage.ErrConflict
+	ErrCycle     = storage.ErrCycle
+	ErrReference = storage.ErrReference
+	ErrInternal  = errors.New("postgres: internal error")
 )
 
 type PGStorage struct {
@@ -32,36 +55,58 @@ func New(dsn string) (*PGStorage, error) {
 	return &PGStorage{db: db}, nil
 }
 
-func (s *PGStorage) Save(ctx context.Context, title, description string) (int64, error) {
+func (s *PGStorage) Save(ctx context.Context, task models.Task) (int64, error) {
+	created, err := insertTask(ctx, s.db, task)
+
+	if err != nil {
+		return -1, err
+	}
+
+	return created.ID, nil
+}
+
+// queryRower is implemented by both *sql.DB and *sql.Tx.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// insertTask creates a task and returns it as stored. A parent or project that does not exist
+// fails with ErrReference.
+func insertTask(ctx context.Context, q queryRower, task models.Task) (models.Task, error) {
 	query := `
-		INSERT INTO tasks (title, description)
-		VALUES ($1, $2) RETURNING id
-	`
-	var id int64
+		INSERT INTO tasks (title, description, priority, due_at, parent_id, recurrence, project_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + taskColumns
+
+	created, err := scanTask(q.QueryRowContext(ctx, query,
+		task.Title,
+		task.Description,
+		task.Priority,
+		nullTime(task.DueAt),
+		nullID(task.ParentID),
+		nullString(task.Recurrence),
+		nullID(task.ProjectID),
+	))
+
+	if err != nil {
+		if foreignKeyViolation(err) {
+			return models.Task{}, ErrReference
+		}
 
-	if err := s.db.QueryRowContext(ctx, query, title, description).Scan(&id); err != nil {
-		return -1, ErrInternal
+		return models.Task{}, ErrInternal
 	}
 
-	return id, nil
+	return created, nil
 }
 
 func (s *PGStorage) Get(ctx context.Context, id int64) (models.Task, error) {
 	query := `
-		SELECT id, title, description, completed, created_at, completed_at
+		SELECT ` + taskColumns + `
 		FROM tasks
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
-	var task models.Task
 
-	err := s.db.QueryRowContext(ctx, query, id).Scan(
-		&task.ID,
-		&task.Title,
-		&task.Description,
-		&task.Completed,
-		&task.CreatedAt,
-		&task.CompletedAt,
-	)
+	task, err := scanTask(s.db.QueryRowContext(ctx, query, id))
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -74,16 +119,100 @@ func (s *PGStorage) Get(ctx context.Context, id int64) (models.Task, error) {
 	return task, nil
 }
 
-func (s *PGStorage) Update(ctx context.Context, id int64, title, description string) error {
+// patchColumns maps the fields a task patch may write to their column and value.
+var patchColumns = map[string]struct {
+	column string
+	value  func(models.Task) any
+}{
+	"title":       {"title", func(t models.Task) any { return t.Title }},
+	"description": {"description", func(t models.Task) any { return t.Description }},
+	"priority":    {"priority", func(t models.Task) any { return t.Priority }},
+	"due_at":      {"due_at", func(t models.Task) any { return nullTime(t.DueAt) }},
+	"recurrence":  {"recurrence", func(t models.Task) any { return nullString(t.Recurrence) }},
+	"project_id":  {"project_id", func(t models.Task) any { return nullID(t.ProjectID) }},
+}
+
+// Patch writes only the listed fields of a task. Fields outside patchColumns are rejected.
+// A non-zero task.Version must match the stored version, otherwise nothing is written and
+// ErrNotFound is returned. An empty field list writes nothing but is checked the same way. A
+// project that does not exist fails with ErrReference.
+func (s *PGStorage) Patch(ctx context.Context, task models.Task, fields []string) error {
+	if len(fields) == 0 {
+		query := `
+			SELECT EXISTS (
+				SELECT 1 FROM tasks WHERE id = $1 AND deleted_at IS NULL AND ($2::bigint = 0 OR version = $2)
+			)
+		`
+		var found bool
+
+		if err := s.db.QueryRowContext(ctx, query, task.ID, task.Version).Scan(&found); err != nil {
+			return ErrInternal
+		}
+
+		if !found {
+			return ErrNotFound
+		}
+
+		return nil
+	}
+
+	sets := make([]string, 0, len(fields))
+	args := make([]any, 0, len(fields)+1)
+
+	for _, field := range fields {
+		col, ok := patchColumns[field]
+
+		if !ok {
+			return ErrInternal
+		}
+
+		args = append(args, col.value(task))
+		sets = append(sets, fmt.Sprintf("%s = $%d", col.column, len(args)))
+	}
+
+	args = append(args, task.ID, task.Version)
+
+	query := fmt.Sprintf(`
+		UPDATE tasks
+		SET %s
+		WHERE id = $%d AND deleted_at IS NULL AND ($%d::bigint = 0 OR version = $%d)
+	`, strings.Join(sets, ", "), len(args)-1, len(args), len(args))
+
+	return s.execAffecting(ctx, query, args...)
+}
+
+// Update overwrites the title of a task and every other field that is set. An empty
+// description or recurrence, a nil due date, a zero project and models.PriorityUnset keep the
+// stored value; Patch clears fields. A non-zero task.Version must match the stored version,
+// otherwise nothing is written and ErrNotFound is returned. A project that does not exist fails with ErrReference.
+func (s *PGStorage) Update(ctx context.Context, task models.Task) error {
 	query := `
 		UPDATE tasks
-		SET title = $1, description = $2
-		WHERE id = $3
+		SET title = $1,
+			description = COALESCE($2, description),
+			priority = CASE WHEN $3::int < 0 THEN priority ELSE $3 END,
+			due_at = COALESCE($4, due_at),
+			recurrence = COALESCE($5, recurrence),
+			project_id = COALESCE($6, project_id)
+		WHERE id = $7 AND deleted_at IS NULL AND ($8::bigint = 0 OR version = $8)
 	`
 
-	res, err := s.db.ExecContext(ctx, query, title, description, id)
+	res, err := s.db.ExecContext(ctx, query,
+		task.Title,
+		nullString(task.Description),
+		task.Priority,
+		nullTime(task.DueAt),
+		nullString(task.Recurrence),
+		nullID(task.ProjectID),
+		task.ID,
+		task.Version,
+	)
 
 	if err != nil {
+		if foreignKeyViolation(err) {
+			return ErrReference
+		}
+
 		return ErrInternal
 	}
 
@@ -100,8 +229,68 @@ func (s *PGStorage) Update(ctx context.Context, id int64, title, description str
 	return nil
 }
 
-func (s *PGStorage) Remove(ctx context.Context, id int64) error {
-	query := `DELETE FROM tasks WHERE id = $1`
+// Remove moves a task and its live subtasks to the trash and returns the ids of the trashed tasks.
+// The whole subtree shares one deleted_at, so Restore can bring it back together. A non-zero
+// version must match the task's stored version, otherwise nothing is trashed.
+func (s *PGStorage) Remove(ctx context.Context, id, version int64) ([]int64, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM tasks WHERE id = $1 AND deleted_at IS NULL AND ($2::bigint = 0 OR version = $2)
+			UNION
+			SELECT t.id FROM tasks t JOIN subtree st ON t.parent_id = st.id WHERE t.deleted_at IS NULL
+		)
+		UPDATE tasks
+		SET deleted_at = NOW()
+		WHERE id IN (SELECT id FROM subtree)
+		RETURNING id
+	`
+
+	return s.queryIDs(ctx, query, id, version)
+}
+
+// GetDeleted returns a task from the trash.
+func (s *PGStorage) GetDeleted(ctx context.Context, id int64) (models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+
+	task, err := scanTask(s.db.QueryRowContext(ctx, query, id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Task{}, ErrNotFound
+		}
+
+		return models.Task{}, ErrInternal
+	}
+
+	return task, nil
+}
+
+// Restore takes a task out of the trash together with the subtasks that were trashed along
+// with it and returns the ids of the restored tasks.
+func (s *PGStorage) Restore(ctx context.Context, id int64) ([]int64, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id, deleted_at FROM tasks WHERE id = $1 AND deleted_at IS NOT NULL
+			UNION
+			SELECT t.id, t.deleted_at FROM tasks t JOIN subtree st ON t.parent_id = st.id
+			WHERE t.deleted_at = st.deleted_at
+		)
+		UPDATE tasks
+		SET deleted_at = NULL
+		WHERE id IN (SELECT id FROM subtree)
+		RETURNING id
+	`
+
+	return s.queryIDs(ctx, query, id)
+}
+
+// Purge permanently deletes a task from the trash. Its subtasks go with it.
+func (s *PGStorage) Purge(ctx context.Context, id int64) error {
+	query := `DELETE FROM tasks WHERE id = $1 AND deleted_at IS NOT NULL`
 
 	res, err := s.db.ExecContext(ctx, query, id)
 
@@ -122,11 +311,61 @@ func (s *PGStorage) Remove(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (s *PGStorage) Complete(ctx context.Context, id int64) error {
+// PurgeDeletedBefore permanently deletes the tasks trashed before t and returns how many were removed.
+func (s *PGStorage) PurgeDeletedBefore(ctx context.Context, t time.Time) (int64, error) {
+	query := `DELETE FROM tasks WHERE deleted_at < $1`
+
+	res, err := s.db.ExecContext(ctx, query, t)
+
+	if err != nil {
+		return 0, ErrInternal
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return 0, ErrInternal
+	}
+
+	return rows, nil
+}
+
+// Archive hides a closed task from the default lists. It returns ErrConflict when the task is
+// still open or already archived.
+func (s *PGStorage) Archive(ctx context.Context, id int64) error {
 	query := `
 		UPDATE tasks
-		SET completed = true, completed_at = NOW()
+		SET archived_at = NOW()
 		WHERE id = $1
+			AND status IN ('done', 'cancelled')
+			AND archived_at IS NULL
+			AND deleted_at IS NULL
+	`
+
+	res, err := s.db.ExecContext(ctx, query, id)
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	if rows == 0 {
+		return ErrConflict
+	}
+
+	return nil
+}
+
+func (s *PGStorage) Unarchive(ctx context.Context, id int64) error {
+	query := `
+		UPDATE tasks
+		SET archived_at = NULL
+		WHERE id = $1 AND archived_at IS NOT NULL AND deleted_at IS NULL
 	`
 
 	res, err := s.db.ExecContext(ctx, query, id)
@@ -148,66 +387,910 @@ func (s *PGStorage) Complete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (s *PGStorage) List(ctx context.Context) ([]models.Task, error) {
+// ArchiveCompletedBefore archives the tasks completed before t and returns their ids.
+func (s *PGStorage) ArchiveCompletedBefore(ctx context.Context, t time.Time) ([]int64, error) {
 	query := `
-		SELECT id, title, description, completed, created_at, completed_at
-		FROM tasks
+		UPDATE tasks
+		SET archived_at = NOW()
+		WHERE status = 'done'
+			AND completed_at < $1
+			AND archived_at IS NULL
+			AND deleted_at IS NULL
+		RETURNING id
 	`
 
-	return s.fetchTasks(ctx, query)
+	ids, err := s.queryIDs(ctx, query, t)
+
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+
+	return ids, err
 }
 
-func (s *PGStorage) ListCompleted(ctx context.Context) ([]models.Task, error) {
+// ListArchived returns the archived tasks, most recently archived first. An empty archive yields an empty slice.
+func (s *PGStorage) ListArchived(ctx context.Context) ([]models.Task, error) {
 	query := `
-		SELECT id, title, description, completed, created_at, completed_at
+		SELECT ` + taskColumns + `
 		FROM tasks
-		WHERE completed = true
+		WHERE archived_at IS NOT NULL AND deleted_at IS NULL
+		ORDER BY archived_at DESC, id
 	`
 
 	return s.fetchTasks(ctx, query)
 }
 
-func (s *PGStorage) ListNotCompleted(ctx context.Context) ([]models.Task, error) {
+// ListDeleted returns the trashed tasks, most recently deleted first. An empty trash yields an empty slice.
+func (s *PGStorage) ListDeleted(ctx context.Context) ([]models.Task, error) {
 	query := `
-		SELECT id, title, description, completed, created_at, completed_at
+		SELECT ` + taskColumns + `
 		FROM tasks
-		WHERE completed = false
+		WHERE deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, id
 	`
 
 	return s.fetchTasks(ctx, query)
 }
 
-func (s *PGStorage) fetchTasks(ctx context.Context, query string, args ...interface{}) ([]models.Task, error) {
-	rows, err := s.db.QueryContext(ctx, query, args...)
+// Transition moves a task from one status to another. It returns ErrConflict when the task
+// is no longer in the from status. A task moved back to an open status leaves the archive, as
+// in Reopen. When next is set, the next occurrence of a recurring task is created in the same
+// transaction.
+func (s *PGStorage) Transition(ctx context.Context, id int64, from, to string, next *models.Task) error {
+	query := `
+		UPDATE tasks
+		SET status = $3,
+			status_changed_at = NOW(),
+			completed_at = CASE WHEN $3 = 'done' THEN NOW() END,
+			archived_at = CASE WHEN $3 IN ('done', 'cancelled') THEN archived_at END
+		WHERE id = $1 AND status = $2 AND deleted_at IS NULL
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, id, from, to)
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	if rows == 0 {
+		return ErrConflict
+	}
+
+	if next != nil {
+		if err := insertOccurrence(ctx, tx, id, *next); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// Reopen moves a done or cancelled task back to todo and clears its completion time.
+// It returns ErrConflict when the task is still open. The occurrence its completion spawned is
+// moved to the trash while it is untouched, that is still in todo and without subtasks; the ids
+// of the trashed tasks are returned.
+func (s *PGStorage) Reopen(ctx context.Context, id int64) ([]int64, error) {
+	query := `
+		UPDATE tasks
+		SET status = 'todo', status_changed_at = NOW(), completed_at = NULL, archived_at = NULL
+		WHERE id = $1 AND status IN ('done', 'cancelled') AND deleted_at IS NULL
+	`
+
+	occurrenceQuery := `
+		UPDATE tasks
+		SET deleted_at = NOW()
+		WHERE recurred_from = $1 AND status = 'todo' AND deleted_at IS NULL
+			AND NOT EXISTS (SELECT 1 FROM tasks c WHERE c.parent_id = tasks.id AND c.deleted_at IS NULL)
+		RETURNING id
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
 
 	if err != nil {
 		return nil, ErrInternal
 	}
 
-	defer rows.Close()
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, id)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	if rows == 0 {
+		return nil, ErrConflict
+	}
+
+	occurrences, err := tx.QueryContext(ctx, occurrenceQuery, id)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	var trashed []int64
+
+	for occurrences.Next() {
+		var occurrence int64
+
+		if err := occurrences.Scan(&occurrence); err != nil {
+			occurrences.Close()
+			return nil, ErrInternal
+		}
+
+		trashed = append(trashed, occurrence)
+	}
+
+	occurrences.Close()
+
+	if err := tx.Commit(); err != nil {
+		return nil, ErrInternal
+	}
+
+	return trashed, nil
+}
+
+// CompleteWithSubtasks completes a task together with its whole subtree and returns the ids
+// of the completed tasks. Cancelled subtasks are left as they are. next is handled as in Transition.
+func (s *PGStorage) CompleteWithSubtasks(ctx context.Context, id int64, next *models.Task) ([]int64, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM tasks WHERE id = $1 AND deleted_at IS NULL
+			UNION
+			SELECT t.id FROM tasks t JOIN subtree st ON t.parent_id = st.id WHERE t.deleted_at IS NULL
+		)
+		UPDATE tasks
+		SET status = 'done', status_changed_at = NOW(), completed_at = NOW()
+		WHERE id IN (SELECT id FROM subtree) AND (status NOT IN ('done', 'cancelled') OR id = $1)
+		RETURNING id
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, id)
 
-	var tasks []models.Task
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	var ids []int64
 
 	for rows.Next() {
-		var task models.Task
-
-		if err := rows.Scan(
-			&task.ID,
-			&task.Title,
-			&task.Description,
-			&task.Completed,
-			&task.CreatedAt,
-			&task.CompletedAt,
-		); err != nil {
+		var completed int64
+
+		if err := rows.Scan(&completed); err != nil {
+			rows.Close()
 			return nil, ErrInternal
 		}
 
-		tasks = append(tasks, task)
+		ids = append(ids, completed)
 	}
 
-	if len(tasks) == 0 {
+	rows.Close()
+
+	if len(ids) == 0 {
 		return nil, ErrNotFound
 	}
 
-	return tasks, nil
+	if next != nil {
+		if err := insertOccurrence(ctx, tx, id, *next); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, ErrInternal
+	}
+
+	return ids, nil
+}
+
+// insertOccurrence creates the next occurrence of a recurring task, carrying over its tags, and
+// links it to prevID. Nothing is created while an earlier occurrence spawned from prevID is still
+// live, so completing a reopened task doesn't spawn a second one.
+func insertOccurrence(ctx context.Context, tx *sql.Tx, prevID int64, next models.Task) error {
+	var spawned bool
+
+	if err := tx.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM tasks WHERE recurred_from = $1 AND deleted_at IS NULL)`, prevID,
+	).Scan(&spawned); err != nil {
+		return ErrInternal
+	}
+
+	if spawned {
+		return nil
+	}
+
+	created, err := insertTask(ctx, tx, next)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE tasks SET recurred_from = $2 WHERE id = $1`, created.ID, prevID,
+	); err != nil {
+		return ErrInternal
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO task_tags (task_id, tag_id) SELECT $1, tag_id FROM task_tags WHERE task_id = $2`,
+		created.ID, prevID,
+	); err != nil {
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (s *PGStorage) CountOpenSubtasks(ctx context.Context, id int64) (int64, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT id, status FROM tasks WHERE parent_id = $1 AND deleted_at IS NULL
+			UNION
+			SELECT t.id, t.status FROM tasks t JOIN subtree st ON t.parent_id = st.id WHERE t.deleted_at IS NULL
+		)
+		SELECT COUNT(*) FROM subtree WHERE status NOT IN ('done', 'cancelled')
+	`
+	var count int64
+
+	if err := s.db.QueryRowContext(ctx, query, id).Scan(&count); err != nil {
+		return 0, ErrInternal
+	}
+
+	return count, nil
+}
+
+// Move re-parents a task; a zero parentID moves it to the top level. It returns ErrNotFound
+// when the task or the parent is not live and ErrCycle when the parent is the task itself or
+// one of its descendants. Writers hold hierarchyLock.
+func (s *PGStorage) Move(ctx context.Context, id, parentID int64) error {
+	parentQuery := `SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND deleted_at IS NULL)`
+
+	cycleQuery := `
+		WITH RECURSIVE subtree AS (
+			SELECT $1::bigint AS id
+			UNION
+			SELECT t.id FROM tasks t JOIN subtree st ON t.parent_id = st.id
+		)
+		SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2)
+	`
+
+	query := `
+		UPDATE tasks
+		SET parent_id = $1
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, hierarchyLock); err != nil {
+		return ErrInternal
+	}
+
+	if parentID != 0 {
+		var live, cycle bool
+
+		if err := tx.QueryRowContext(ctx, parentQuery, parentID).Scan(&live); err != nil {
+			return ErrInternal
+		}
+
+		if !live {
+			return ErrNotFound
+		}
+
+		if err := tx.QueryRowContext(ctx, cycleQuery, id, parentID).Scan(&cycle); err != nil {
+			return ErrInternal
+		}
+
+		if cycle {
+			return ErrCycle
+		}
+	}
+
+	res, err := tx.ExecContext(ctx, query, nullID(parentID), id)
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	if rows == 0 {
+		return ErrNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// List returns one page of the live, unarchived tasks that match the filter. No match yields an empty slice.
+func (s *PGStorage) List(ctx context.Context, filter models.TaskFilter, page models.Page) ([]models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE deleted_at IS NULL AND archived_at IS NULL
+	`
+
+	query, args := filterTasks(query, filter)
+
+	query, args, err := paginate(query, page, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fetchTasks(ctx, query, args...)
+}
+
+func (s *PGStorage) ListCompleted(ctx context.Context, page models.Page) ([]models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE status = 'done' AND deleted_at IS NULL AND archived_at IS NULL
+	`
+
+	query, args, err := paginate(query, page, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fetchTasks(ctx, query, args...)
+}
+
+func (s *PGStorage) ListNotCompleted(ctx context.Context, page models.Page) ([]models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE status NOT IN ('done', 'cancelled') AND deleted_at IS NULL AND archived_at IS NULL
+	`
+
+	query, args, err := paginate(query, page, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fetchTasks(ctx, query, args...)
+}
+
+func (s *PGStorage) ListByPriority(ctx context.Context) ([]models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE deleted_at IS NULL AND archived_at IS NULL
+		ORDER BY priority DESC, created_at, id
+	`
+
+	return s.fetchTasks(ctx, query)
+}
+
+func (s *PGStorage) ListOverdue(ctx context.Context) ([]models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE status NOT IN ('done', 'cancelled') AND due_at < NOW() AND deleted_at IS NULL
+		ORDER BY due_at, id
+	`
+
+	return s.fetchTasks(ctx, query)
+}
+
+func (s *PGStorage) ListDueBetween(ctx context.Context, from, to time.Time) ([]models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE due_at >= $1 AND due_at < $2 AND deleted_at IS NULL AND archived_at IS NULL
+		ORDER BY due_at, id
+	`
+
+	return s.fetchTasks(ctx, query, from, to)
+}
+
+// ListSubtasks returns the direct children of a task, or its whole subtree when recursive is set.
+// A task without subtasks yields an empty slice.
+func (s *PGStorage) ListSubtasks(ctx context.Context, id int64, recursive bool) ([]models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE parent_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at, id
+	`
+
+	if recursive {
+		query = `
+			WITH RECURSIVE subtree AS (
+				SELECT id FROM tasks WHERE parent_id = $1 AND deleted_at IS NULL
+				UNION
+				SELECT t.id FROM tasks t JOIN subtree st ON t.parent_id = st.id WHERE t.deleted_at IS NULL
+			)
+			SELECT ` + taskColumns + `
+			FROM tasks
+			WHERE id IN (SELECT id FROM subtree)
+			ORDER BY created_at, id
+		`
+	}
+
+	return s.fetchTasks(ctx, query, id)
+}
+
+func (s *PGStorage) ListByTags(ctx context.Context, tags []string, matchAll bool) ([]models.Task, error) {
+	args := make([]any, 0, len(tags)+1)
+	placeholders := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		args = append(args, tag)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+	}
+
+	having := ""
+
+	if matchAll {
+		args = append(args, len(tags))
+		having = fmt.Sprintf("HAVING COUNT(DISTINCT tg.name) = $%d", len(args))
+	}
+
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE deleted_at IS NULL AND archived_at IS NULL AND id IN (
+			SELECT tt.task_id
+			FROM task_tags tt
+			JOIN tags tg ON tg.id = tt.tag_id
+			WHERE tg.name IN (` + strings.Join(placeholders, ", ") + `)
+			GROUP BY tt.task_id
+			` + having + `
+		)
+		ORDER BY created_at, id
+	`
+
+	return s.fetchTasks(ctx, query, args...)
+}
+
+func (s *PGStorage) AttachTag(ctx context.Context, taskID int64, tag string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	defer tx.Rollback()
+
+	var exists bool
+
+	if err := tx.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND deleted_at IS NULL)`, taskID,
+	).Scan(&exists); err != nil {
+		return ErrInternal
+	}
+
+	if !exists {
+		return ErrNotFound
+	}
+
+	query := `
+		WITH tag AS (
+			INSERT INTO tags (name) VALUES ($2)
+			ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+			RETURNING id
+		)
+		INSERT INTO task_tags (task_id, tag_id)
+		SELECT $1, id FROM tag
+		ON CONFLICT DO NOTHING
+	`
+
+	if _, err := tx.ExecContext(ctx, query, taskID, tag); err != nil {
+		return ErrInternal
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (s *PGStorage) DetachTag(ctx context.Context, taskID int64, tag string) error {
+	query := `
+		DELETE FROM task_tags
+		WHERE task_id = $1 AND tag_id = (SELECT id FROM tags WHERE name = $2)
+	`
+
+	res, err := s.db.ExecContext(ctx, query, taskID, tag)
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	if rows == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *PGStorage) ListTags(ctx context.Context) ([]models.Tag, error) {
+	query := `
+		SELECT tg.name, COUNT(t.id)
+		FROM tags tg
+		LEFT JOIN task_tags tt ON tt.tag_id = tg.id
+		LEFT JOIN tasks t ON t.id = tt.task_id AND t.deleted_at IS NULL
+		GROUP BY tg.name
+		ORDER BY tg.name
+	`
+
+	rows, err := s.db.QueryContext(ctx, query)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	defer rows.Close()
+
+	tags := []models.Tag{}
+
+	for rows.Next() {
+		var tag models.Tag
+
+		if err := rows.Scan(&tag.Name, &tag.Count); err != nil {
+			return nil, ErrInternal
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+// AddDependency records that taskID is blocked by blockedByID. It returns ErrNotFound when
+// either task is not live and ErrCycle when blockedByID already waits on taskID. Writers hold
+// dependencyLock, so the cycle check and the insert see the same graph.
+func (s *PGStorage) AddDependency(ctx context.Context, taskID, blockedByID int64) error {
+	liveQuery := `SELECT COUNT(*) FROM tasks WHERE id IN ($1, $2) AND deleted_at IS NULL`
+
+	cycleQuery := `
+		WITH RECURSIVE blockers AS (
+			SELECT $1::bigint AS id
+			UNION
+			SELECT d.blocked_by_id FROM task_dependencies d JOIN blockers b ON d.task_id = b.id
+		)
+		SELECT EXISTS (SELECT 1 FROM blockers WHERE id = $2)
+	`
+
+	insertQuery := `
+		INSERT INTO task_dependencies (task_id, blocked_by_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, dependencyLock); err != nil {
+		return ErrInternal
+	}
+
+	var live int
+
+	if err := tx.QueryRowContext(ctx, liveQuery, taskID, blockedByID).Scan(&live); err != nil {
+		return ErrInternal
+	}
+
+	if live < 2 {
+		return ErrNotFound
+	}
+
+	var cycle bool
+
+	if err := tx.QueryRowContext(ctx, cycleQuery, blockedByID, taskID).Scan(&cycle); err != nil {
+		return ErrInternal
+	}
+
+	if cycle {
+		return ErrCycle
+	}
+
+	if _, err := tx.ExecContext(ctx, insertQuery, taskID, blockedByID); err != nil {
+		return ErrInternal
+	}
+
+	if err := tx.Commit(); err != nil {
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (s *PGStorage) RemoveDependency(ctx context.Context, taskID, blockedByID int64) error {
+	query := `
+		DELETE FROM task_dependencies
+		WHERE task_id = $1 AND blocked_by_id = $2
+	`
+
+	res, err := s.db.ExecContext(ctx, query, taskID, blockedByID)
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	if rows == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ListBlockers returns the tasks that block the given one. A task without blockers yields an empty slice.
+func (s *PGStorage) ListBlockers(ctx context.Context, id int64) ([]models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE id IN (SELECT blocked_by_id FROM task_dependencies WHERE task_id = $1) AND deleted_at IS NULL
+		ORDER BY created_at, id
+	`
+
+	return s.fetchTasks(ctx, query, id)
+}
+
+// CountOpenBlockers counts the not yet completed tasks blocking the given one. With withSubtasks set,
+// blockers of the whole subtree are counted, except those that are part of the subtree themselves.
+func (s *PGStorage) CountOpenBlockers(ctx context.Context, id int64, withSubtasks bool) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM task_dependencies d
+		JOIN tasks b ON b.id = d.blocked_by_id
+		WHERE d.task_id = $1 AND b.status NOT IN ('done', 'cancelled') AND b.deleted_at IS NULL
+	`
+
+	if withSubtasks {
+		query = `
+			WITH RECURSIVE subtree AS (
+				SELECT id FROM tasks WHERE id = $1
+				UNION
+				SELECT t.id FROM tasks t JOIN subtree st ON t.parent_id = st.id WHERE t.deleted_at IS NULL
+			)
+			SELECT COUNT(*)
+			FROM task_dependencies d
+			JOIN tasks b ON b.id = d.blocked_by_id
+			WHERE d.task_id IN (SELECT id FROM subtree)
+				AND b.status NOT IN ('done', 'cancelled')
+				AND b.deleted_at IS NULL
+				AND b.id NOT IN (SELECT id FROM subtree)
+		`
+	}
+
+	var count int64
+
+	if err := s.db.QueryRowContext(ctx, query, id).Scan(&count); err != nil {
+		return 0, ErrInternal
+	}
+
+	return count, nil
+}
+
+func (s *PGStorage) ListReady(ctx context.Context) ([]models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE status NOT IN ('done', 'cancelled') AND deleted_at IS NULL AND NOT EXISTS (
+			SELECT 1
+			FROM task_dependencies d
+			JOIN tasks b ON b.id = d.blocked_by_id
+			WHERE d.task_id = tasks.id AND b.status NOT IN ('done', 'cancelled') AND b.deleted_at IS NULL
+		)
+		ORDER BY priority DESC, created_at, id
+	`
+
+	return s.fetchTasks(ctx, query)
+}
+
+func (s *PGStorage) queryIDs(ctx context.Context, query string, args ...any) ([]int64, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	defer rows.Close()
+
+	var ids []int64
+
+	for rows.Next() {
+		var id int64
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, ErrInternal
+		}
+
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return ids, nil
+}
+
+func (s *PGStorage) fetchTasks(ctx context.Context, query string, args ...interface{}) ([]models.Task, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	defer rows.Close()
+
+	tasks := []models.Task{}
+
+	for rows.Next() {
+		task, err := scanTask(rows)
+
+		if err != nil {
+			return nil, ErrInternal
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTask(row scanner) (models.Task, error) {
+	var (
+		task          models.Task
+		statusChanged sql.NullTime
+		createdAt     time.Time
+		completedAt   sql.NullTime
+		dueAt         sql.NullTime
+		parentID      sql.NullInt64
+		recurrence    sql.NullString
+		projectID     sql.NullInt64
+		deletedAt     sql.NullTime
+		archivedAt    sql.NullTime
+		tags          sql.NullString
+	)
+
+	if err := row.Scan(
+		&task.ID,
+		&task.Title,
+		&task.Description,
+		&task.Status,
+		&statusChanged,
+		&task.Priority,
+		&createdAt,
+		&completedAt,
+		&dueAt,
+		&parentID,
+		&recurrence,
+		&projectID,
+		&deletedAt,
+		&archivedAt,
+		&task.Version,
+		&tags,
+	); err != nil {
+		return models.Task{}, err
+	}
+
+	task.Completed = task.Status == models.StatusDone
+	task.CreatedAt = timestamppb.New(createdAt)
+
+	if statusChanged.Valid {
+		task.StatusChangedAt = timestamppb.New(statusChanged.Time)
+	}
+
+	if completedAt.Valid {
+		task.CompletedAt = timestamppb.New(completedAt.Time)
+	}
+
+	if dueAt.Valid {
+		task.DueAt = timestamppb.New(dueAt.Time)
+	}
+
+	if parentID.Valid {
+		task.ParentID = parentID.Int64
+	}
+
+	if recurrence.Valid {
+		task.Recurrence = recurrence.String
+	}
+
+	if projectID.Valid {
+		task.ProjectID = projectID.Int64
+	}
+
+	if deletedAt.Valid {
+		task.DeletedAt = timestamppb.New(deletedAt.Time)
+	}
+
+	if archivedAt.Valid {
+		task.ArchivedAt = timestamppb.New(archivedAt.Time)
+	}
+
+	if tags.Valid {
+		task.Tags = strings.Split(tags.String, ",")
+	}
+
+	return task, nil
+}
+
+func nullTime(ts *timestamppb.Timestamp) sql.NullTime {
+	if ts == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: ts.AsTime(), Valid: true}
+}
+
+func nullID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id > 0}
+}
+
+func nullString(v string) sql.NullString {
+	return sql.NullString{String: v, Valid: v != ""}
 }
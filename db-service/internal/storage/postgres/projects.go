@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"todo/db/internal/domain/models"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const projectColumns = `id, name, description, created_at, archived_at`
+
+func (s *PGStorage) SaveProject(ctx context.Context, project models.Project) (int64, error) {
+	query := `
+		INSERT INTO projects (name, description)
+		VALUES ($1, $2) RETURNING id
+	`
+	var id int64
+
+	if err := s.db.QueryRowContext(ctx, query, project.Name, project.Description).Scan(&id); err != nil {
+		return -1, ErrInternal
+	}
+
+	return id, nil
+}
+
+func (s *PGStorage) GetProject(ctx context.Context, id int64) (models.Project, error) {
+	query := `
+		SELECT ` + projectColumns + `
+		FROM projects
+		WHERE id = $1
+	`
+
+	project, err := scanProject(s.db.QueryRowContext(ctx, query, id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Project{}, ErrNotFound
+		}
+
+		return models.Project{}, ErrInternal
+	}
+
+	return project, nil
+}
+
+func (s *PGStorage) UpdateProject(ctx context.Context, project models.Project) error {
+	query := `
+		UPDATE projects
+		SET name = $1, description = $2
+		WHERE id = $3
+	`
+
+	return s.execAffecting(ctx, query, project.Name, project.Description, project.ID)
+}
+
+// ArchiveProject hides a project from the default listing but keeps it and its tasks.
+func (s *PGStorage) ArchiveProject(ctx context.Context, id int64) error {
+	query := `
+		UPDATE projects
+		SET archived_at = NOW()
+		WHERE id = $1 AND archived_at IS NULL
+	`
+
+	return s.execAffecting(ctx, query, id)
+}
+
+// RemoveProject deletes a project and, in the same transaction, moves its live tasks and their
+// subtasks to the trash with one shared deleted_at. The foreign key detaches the trashed tasks
+// from the deleted project, so they can still be restored. It returns the ids of the trashed tasks.
+func (s *PGStorage) RemoveProject(ctx context.Context, id int64) ([]int64, error) {
+	trash := `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM tasks WHERE project_id = $1 AND deleted_at IS NULL
+			UNION
+			SELECT t.id FROM tasks t JOIN subtree st ON t.parent_id = st.id WHERE t.deleted_at IS NULL
+		)
+		UPDATE tasks
+		SET deleted_at = NOW()
+		WHERE id IN (SELECT id FROM subtree)
+		RETURNING id
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, trash, id)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	var ids []int64
+
+	for rows.Next() {
+		var taskID int64
+
+		if err := rows.Scan(&taskID); err != nil {
+			rows.Close()
+			return nil, ErrInternal
+		}
+
+		ids = append(ids, taskID)
+	}
+
+	rows.Close()
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM projects WHERE id = $1`, id)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	deleted, err := res.RowsAffected()
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	if deleted == 0 {
+		return nil, ErrNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, ErrInternal
+	}
+
+	return ids, nil
+}
+
+// ListProjects returns the projects, the archived ones only with includeArchived set. No
+// project yields an empty slice.
+func (s *PGStorage) ListProjects(ctx context.Context, includeArchived bool) ([]models.Project, error) {
+	query := `
+		SELECT ` + projectColumns + `
+		FROM projects
+		WHERE $1 OR archived_at IS NULL
+		ORDER BY created_at, id
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, includeArchived)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	defer rows.Close()
+
+	projects := []models.Project{}
+
+	for rows.Next() {
+		project, err := scanProject(rows)
+
+		if err != nil {
+			return nil, ErrInternal
+		}
+
+		projects = append(projects, project)
+	}
+
+	return projects, nil
+}
+
+// ListProjectTasks returns the tasks of a project. A project without tasks yields an empty slice.
+func (s *PGStorage) ListProjectTasks(ctx context.Context, projectID int64) ([]models.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE project_id = $1 AND deleted_at IS NULL AND archived_at IS NULL
+		ORDER BY created_at, id
+	`
+
+	return s.fetchTasks(ctx, query, projectID)
+}
+
+// execAffecting runs a write that must change at least one row. No row changed yields
+// ErrNotFound and a foreign key that points nowhere ErrReference.
+func (s *PGStorage) execAffecting(ctx context.Context, query string, args ...any) error {
+	res, err := s.db.ExecContext(ctx, query, args...)
+
+	if err != nil {
+		if foreignKeyViolation(err) {
+			return ErrReference
+		}
+
+		return ErrInternal
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	if rows == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func scanProject(row scanner) (models.Project, error) {
+	var (
+		project     models.Project
+		description sql.NullString
+		createdAt   time.Time
+		archivedAt  sql.NullTime
+	)
+
+	if err := row.Scan(
+		&project.ID,
+		&project.Name,
+		&description,
+		&createdAt,
+		&archivedAt,
+	); err != nil {
+		return models.Project{}, err
+	}
+
+	project.Description = description.String
+	project.CreatedAt = timestamppb.New(createdAt)
+
+	if archivedAt.Valid {
+		project.ArchivedAt = timestamppb.New(archivedAt.Time)
+	}
+
+	return project, nil
+}
@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"todo/db/internal/domain/models"
+)
+
+// sortColumns whitelists the columns a task list can be ordered by, mapped to the type
+// that cursor values are cast to when they are compared against the column.
+var sortColumns = map[string]string{
+	"created_at": "timestamp",
+	"title":      "text",
+	"priority":   "integer",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// filterTasks appends the filter's conditions to a query that already has a WHERE clause.
+func filterTasks(query string, filter models.TaskFilter) (string, []any) {
+	var args []any
+
+	if filter.Query != "" {
+		args = append(args, "%"+likeEscaper.Replace(filter.Query)+"%")
+		query += fmt.Sprintf("AND (title ILIKE $%d OR description ILIKE $%d)\n", len(args), len(args))
+	}
+
+	if filter.Completed != nil {
+		if *filter.Completed {
+			query += "AND status = 'done'\n"
+		} else {
+			query += "AND status NOT IN ('done', 'cancelled')\n"
+		}
+	}
+
+	if !filter.CreatedAfter.IsZero() {
+		args = append(args, filter.CreatedAfter)
+		query += fmt.Sprintf("AND created_at > $%d\n", len(args))
+	}
+
+	if !filter.CreatedBefore.IsZero() {
+		args = append(args, filter.CreatedBefore)
+		query += fmt.Sprintf("AND created_at < $%d\n", len(args))
+	}
+
+	if filter.Expr != nil {
+		var cond string
+
+		cond, args = compileFilter(filter.Expr, time.Now(), args)
+		query += "AND " + cond + "\n"
+	}
+
+	return query, args
+}
+
+// paginate appends the keyset condition, ORDER BY and LIMIT of a page to a query that already
+// has a WHERE clause. Column names only ever come from sortColumns.
+func paginate(query string, page models.Page, args []any) (string, []any, error) {
+	sort := page.Sort
+
+	if len(sort) == 0 {
+		sort = models.DefaultSort
+	}
+
+	for _, key := range sort {
+		if _, ok := sortColumns[key.Field]; !ok {
+			return "", nil, ErrInternal
+		}
+	}
+
+	if page.After != nil {
+		if len(page.After.Values) != len(sort) {
+			return "", nil, ErrInternal
+		}
+
+		var cond string
+
+		cond, args = keyset(sort, page.After, args)
+		query += "AND " + cond + "\n"
+	}
+
+	order := make([]string, 0, len(sort)+1)
+
+	for _, key := range sort {
+		if key.Desc {
+			order = append(order, key.Field+" DESC")
+		} else {
+			order = append(order, key.Field)
+		}
+	}
+
+	order = append(order, "id")
+
+	args = append(args, page.Size)
+	query += fmt.Sprintf("ORDER BY %s LIMIT $%d", strings.Join(order, ", "), len(args))
+
+	return query, args, nil
+}
+
+// keyset builds the condition that selects the rows sorting after the cursor. An all-ascending
+// order compares one row value; a mixed order expands into
+// (k1 > v1) OR (k1 = v1 AND k2 < v2) OR ... OR (k1 = v1 AND ... AND id > v).
+func keyset(sort []models.SortKey, after *models.Cursor, args []any) (string, []any) {
+	cols := make([]string, 0, len(sort)+1)
+	params := make([]string, 0, len(sort)+1)
+	ascending := true
+
+	for i, key := range sort {
+		args = append(args, after.Values[i])
+		cols = append(cols, key.Field)
+		params = append(params, fmt.Sprintf("$%d::%s", len(args), sortColumns[key.Field]))
+		ascending = ascending && !key.Desc
+	}
+
+	args = append(args, after.ID)
+	cols = append(cols, "id")
+	params = append(params, fmt.Sprintf("$%d::bigint", len(args)))
+
+	if ascending {
+		return fmt.Sprintf("(%s) > (%s)", strings.Join(cols, ", "), strings.Join(params, ", ")), args
+	}
+
+	terms := make([]string, 0, len(cols))
+
+	for i := range cols {
+		op := ">"
+
+		if i < len(sort) && sort[i].Desc {
+			op = "<"
+		}
+
+		parts := make([]string, 0, i+1)
+
+		for j := 0; j < i; j++ {
+			parts = append(parts, cols[j]+" = "+params[j])
+		}
+
+		parts = append(parts, cols[i]+" "+op+" "+params[i])
+		terms = append(terms, "("+strings.Join(parts, " AND ")+")")
+	}
+
+	return "(" + strings.Join(terms, " OR ") + ")", args
+}
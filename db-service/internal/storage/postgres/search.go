@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"todo/db/internal/domain/models"
+)
+
+// searchColumns maps the text search configurations tasks are indexed in to their tsvector column.
+var searchColumns = map[string]string{
+	"english": "search_english",
+	"russian": "search_russian",
+}
+
+const headlineOptions = "MaxFragments=2, MaxWords=20, MinWords=5, FragmentDelimiter= … "
+
+// escapeHTML wraps a text SQL expression so its HTML special characters come out as entities.
+// ts_headline passes markup in its input through unchanged, so the stored text is escaped
+// first and the <b></b> it adds are the only tags in a snippet.
+func escapeHTML(expr string) string {
+	return `replace(replace(replace(replace(` + expr + `, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;')`
+}
+
+// Search returns the live, unarchived tasks that match a tsquery in the given text search
+// configuration, best match first. The snippet highlights the description, or the title when
+// only the title matches. No match yields an empty slice.
+func (s *PGStorage) Search(ctx context.Context, language, tsquery string, limit int) ([]models.SearchHit, error) {
+	column, ok := searchColumns[language]
+
+	if !ok {
+		return nil, ErrInternal
+	}
+
+	query := `
+		SELECT ` + taskColumns + `,
+			ts_rank_cd(` + column + `, q) AS rank,
+			CASE
+				WHEN to_tsvector($1::regconfig, coalesce(description, '')) @@ q
+				THEN ts_headline($1::regconfig, ` + escapeHTML("coalesce(description, '')") + `, q, $4)
+				ELSE ts_headline($1::regconfig, ` + escapeHTML("title") + `, q, $4)
+			END AS snippet
+		FROM tasks, to_tsquery($1::regconfig, $2) AS q
+		WHERE ` + column + ` @@ q AND deleted_at IS NULL AND archived_at IS NULL
+		ORDER BY rank DESC, id
+		LIMIT $3
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, language, tsquery, limit, headlineOptions)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	defer rows.Close()
+
+	var hits []models.SearchHit
+
+	for rows.Next() {
+		var hit models.SearchHit
+
+		task, err := scanTask(extraScanner{row: rows, extra: []any{&hit.Rank, &hit.Snippet}})
+
+		if err != nil {
+			return nil, ErrInternal
+		}
+
+		hit.Task = task
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ErrInternal
+	}
+
+	return hits, nil
+}
+
+// extraScanner scans a row that carries extra columns after the task columns.
+type extraScanner struct {
+	row   scanner
+	extra []any
+}
+
+func (e extraScanner) Scan(dest ...any) error {
+	return e.row.Scan(append(dest, e.extra...)...)
+}
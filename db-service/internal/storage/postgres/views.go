@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"todo/db/internal/domain/models"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const viewColumns = `id, owner, name, query, completed, created_after, created_before, expression, sort, created_at`
+
+// SaveView stores a view. It returns ErrConflict when the owner already has a view with that name.
+func (s *PGStorage) SaveView(ctx context.Context, view models.SavedView) (int64, error) {
+	query := `
+		INSERT INTO saved_views (owner, name, query, completed, created_after, created_before, expression, sort)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (owner, name) DO NOTHING
+		RETURNING id
+	`
+	var id int64
+
+	err := s.db.QueryRowContext(ctx, query,
+		view.Owner,
+		view.Name,
+		view.Filter.Query,
+		nullBool(view.Filter.Completed),
+		nullZeroTime(view.Filter.CreatedAfter),
+		nullZeroTime(view.Filter.CreatedBefore),
+		view.Filter.Expression,
+		models.SortSpec(view.Sort),
+	).Scan(&id)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return -1, ErrConflict
+		}
+
+		return -1, ErrInternal
+	}
+
+	return id, nil
+}
+
+// GetView returns one of the owner's views. A view of another owner is reported as ErrNotFound.
+func (s *PGStorage) GetView(ctx context.Context, id int64, owner string) (models.SavedView, error) {
+	query := `
+		SELECT ` + viewColumns + `
+		FROM saved_views
+		WHERE id = $1 AND owner = $2
+	`
+
+	view, err := scanView(s.db.QueryRowContext(ctx, query, id, owner))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.SavedView{}, ErrNotFound
+		}
+
+		return models.SavedView{}, ErrInternal
+	}
+
+	return view, nil
+}
+
+// UpdateView replaces the name and criteria of one of the owner's views; the owner stays. It
+// returns ErrNotFound for a view of another owner and ErrConflict when the owner has another
+// view with the new name.
+func (s *PGStorage) UpdateView(ctx context.Context, view models.SavedView) error {
+	query := `
+		UPDATE saved_views
+		SET name = $3, query = $4, completed = $5, created_after = $6, created_before = $7, expression = $8, sort = $9
+		WHERE id = $1 AND owner = $2
+	`
+
+	res, err := s.db.ExecContext(ctx, query,
+		view.ID,
+		view.Owner,
+		view.Name,
+		view.Filter.Query,
+		nullBool(view.Filter.Completed),
+		nullZeroTime(view.Filter.CreatedAfter),
+		nullZeroTime(view.Filter.CreatedBefore),
+		view.Filter.Expression,
+		models.SortSpec(view.Sort),
+	)
+
+	if err != nil {
+		if uniqueViolation(err) {
+			return ErrConflict
+		}
+
+		return ErrInternal
+	}
+
+	updated, err := res.RowsAffected()
+
+	if err != nil {
+		return ErrInternal
+	}
+
+	if updated == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// RemoveView deletes one of the owner's views. A view of another owner is reported as ErrNotFound.
+func (s *PGStorage) RemoveView(ctx context.Context, id int64, owner string) error {
+	query := `DELETE FROM saved_views WHERE id = $1 AND owner = $2`
+
+	return s.execAffecting(ctx, query, id, owner)
+}
+
+// ListViews returns the owner's views by name. No views yields an empty slice.
+func (s *PGStorage) ListViews(ctx context.Context, owner string) ([]models.SavedView, error) {
+	query := `
+		SELECT ` + viewColumns + `
+		FROM saved_views
+		WHERE owner = $1
+		ORDER BY name, id
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, owner)
+
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	defer rows.Close()
+
+	var views []models.SavedView
+
+	for rows.Next() {
+		view, err := scanView(rows)
+
+		if err != nil {
+			return nil, ErrInternal
+		}
+
+		views = append(views, view)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, ErrInternal
+	}
+
+	return views, nil
+}
+
+func scanView(row scanner) (models.SavedView, error) {
+	var (
+		view          models.SavedView
+		completed     sql.NullBool
+		createdAfter  sql.NullTime
+		createdBefore sql.NullTime
+		sort          string
+		createdAt     time.Time
+	)
+
+	if err := row.Scan(
+		&view.ID,
+		&view.Owner,
+		&view.Name,
+		&view.Filter.Query,
+		&completed,
+		&createdAfter,
+		&createdBefore,
+		&view.Filter.Expression,
+		&sort,
+		&createdAt,
+	); err != nil {
+		return models.SavedView{}, err
+	}
+
+	if completed.Valid {
+		view.Filter.Completed = &completed.Bool
+	}
+
+	view.Filter.CreatedAfter = createdAfter.Time
+	view.Filter.CreatedBefore = createdBefore.Time
+	view.Sort = models.ParseSortSpec(sort)
+	view.CreatedAt = timestamppb.New(createdAt)
+
+	return view, nil
+}
+
+// uniqueViolation reports whether err is a Postgres unique_violation. The SQLSTATE is read
+// through the method both lib/pq and pgx errors implement, so no driver is imported here.
+func uniqueViolation(err error) bool {
+	var pgErr interface{ SQLState() string }
+
+	return errors.As(err, &pgErr) && pgErr.SQLState() == "23505"
+}
+
+// foreignKeyViolation reports whether err is a Postgres foreign_key_violation, read the same
+// way as in uniqueViolation.
+func foreignKeyViolation(err error) bool {
+	var pgErr interface{ SQLState() string }
+
+	return errors.As(err, &pgErr) && pgErr.SQLState() == "23503"
+}
+
+func nullBool(b *bool) sql.NullBool {
+	if b == nil {
+		return sql.NullBool{}
+	}
+
+	return sql.NullBool{Bool: *b, Valid: true}
+}
+
+func nullZeroTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
@@ -0,0 +1,12 @@
+package storage
+
+import "errors"
+
+// Errors shared by the storage implementations, so layers above can tell them apart without
+// depending on a particular database.
+var (
+	ErrNotFound  = errors.New("storage: not found")
+	ErrConflict  = errors.New("storage: conflict")
+	ErrCycle     = errors.New("storage: cycle")
+	ErrReference = errors.New("storage: referenced task or project does not exist")
+)
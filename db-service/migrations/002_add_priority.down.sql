@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_tasks_priority;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS priority;
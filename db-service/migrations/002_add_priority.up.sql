@@ -0,0 +1,4 @@
+ALTER TABLE tasks
+    ADD COLUMN priority SMALLINT NOT NULL DEFAULT 0 CHECK (priority BETWEEN 0 AND 4);
+
+CREATE INDEX IF NOT EXISTS idx_tasks_priority ON tasks (priority DESC, created_at);
@@ -0,0 +1,3 @@
+ALTER TABLE tasks ADD COLUMN due_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_due_at ON tasks (due_at) WHERE due_at IS NOT NULL;
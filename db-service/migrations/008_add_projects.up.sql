@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS projects (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    archived_at TIMESTAMP
+);
+
+ALTER TABLE tasks
+    ADD COLUMN project_id INTEGER REFERENCES projects (id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_project_id ON tasks (project_id);
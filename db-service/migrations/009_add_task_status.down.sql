@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_tasks_status;
+
+ALTER TABLE tasks ADD COLUMN completed BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE tasks SET completed = true WHERE status = 'done';
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS status_changed_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,12 @@
+ALTER TABLE tasks
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'todo'
+        CHECK (status IN ('todo', 'in_progress', 'review', 'done', 'cancelled')),
+    ADD COLUMN status_changed_at TIMESTAMP;
+
+UPDATE tasks
+SET status = 'done', status_changed_at = completed_at
+WHERE completed = true;
+
+ALTER TABLE tasks DROP COLUMN completed;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_status ON tasks (status);
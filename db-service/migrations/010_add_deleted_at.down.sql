@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_tasks_deleted_at;
+
+DELETE FROM tasks WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS deleted_at;
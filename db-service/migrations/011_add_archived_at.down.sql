@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_tasks_archived_at;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,3 @@
+ALTER TABLE tasks ADD COLUMN archived_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_archived_at ON tasks (archived_at) WHERE archived_at IS NOT NULL;
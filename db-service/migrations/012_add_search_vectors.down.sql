@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_tasks_search_russian;
+DROP INDEX IF EXISTS idx_tasks_search_english;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS search_russian;
+ALTER TABLE tasks DROP COLUMN IF EXISTS search_english;
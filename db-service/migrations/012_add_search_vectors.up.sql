@@ -0,0 +1,12 @@
+ALTER TABLE tasks ADD COLUMN search_english tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B')
+) STORED;
+
+ALTER TABLE tasks ADD COLUMN search_russian tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(description, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_search_english ON tasks USING GIN (search_english);
+CREATE INDEX IF NOT EXISTS idx_tasks_search_russian ON tasks USING GIN (search_russian);
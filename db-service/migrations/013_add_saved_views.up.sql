@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS saved_views (
+    id SERIAL PRIMARY KEY,
+    owner TEXT NOT NULL,
+    name TEXT NOT NULL,
+    query TEXT NOT NULL DEFAULT '',
+    completed BOOLEAN,
+    created_after TIMESTAMP,
+    created_before TIMESTAMP,
+    expression TEXT NOT NULL DEFAULT '',
+    sort TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (owner, name)
+);
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS task_tags_touch_task ON task_tags;
+DROP FUNCTION IF EXISTS touch_tagged_task();
+
+DROP TRIGGER IF EXISTS tasks_bump_version ON tasks;
+DROP FUNCTION IF EXISTS bump_task_version();
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS version;
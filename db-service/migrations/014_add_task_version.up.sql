@@ -0,0 +1,24 @@
+ALTER TABLE tasks ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE FUNCTION bump_task_version() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version := OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tasks_bump_version
+    BEFORE UPDATE ON tasks
+    FOR EACH ROW EXECUTE FUNCTION bump_task_version();
+
+CREATE OR REPLACE FUNCTION touch_tagged_task() RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE tasks SET version = version
+    WHERE id = COALESCE(NEW.task_id, OLD.task_id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER task_tags_touch_task
+    AFTER INSERT OR DELETE ON task_tags
+    FOR EACH ROW EXECUTE FUNCTION touch_tagged_task();
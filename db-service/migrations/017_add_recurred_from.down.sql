@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_tasks_recurred_from;
+ALTER TABLE tasks DROP COLUMN IF EXISTS recurred_from;
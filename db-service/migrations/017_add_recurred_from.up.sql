@@ -0,0 +1,2 @@
+ALTER TABLE tasks ADD COLUMN recurred_from BIGINT REFERENCES tasks (id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_recurred_from ON tasks (recurred_from);
@@ -9,6 +9,7 @@ package dbpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,27 +23,2299 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Priority int32
+
+const (
+	Priority_PRIORITY_NONE   Priority = 0
+	Priority_PRIORITY_LOW    Priority = 1
+	Priority_PRIORITY_MEDIUM Priority = 2
+	Priority_PRIORITY_HIGH   Priority = 3
+	Priority_PRIORITY_URGENT Priority = 4
+)
+
+// Enum value maps for Priority.
+var (
+	Priority_name = map[int32]string{
+		0: "PRIORITY_NONE",
+		1: "PRIORITY_LOW",
+		2: "PRIORITY_MEDIUM",
+		3: "PRIORITY_HIGH",
+		4: "PRIORITY_URGENT",
+	}
+	Priority_value = map[string]int32{
+		"PRIORITY_NONE":   0,
+		"PRIORITY_LOW":    1,
+		"PRIORITY_MEDIUM": 2,
+		"PRIORITY_HIGH":   3,
+		"PRIORITY_URGENT": 4,
+	}
+)
+
+func (x Priority) Enum() *Priority {
+	p := new(Priority)
+	*p = x
+	return p
+}
+
+func (x Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_proto_enumTypes[0].Descriptor()
+}
+
+func (Priority) Type() protoreflect.EnumType {
+	return &file_db_proto_enumTypes[0]
+}
+
+func (x Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Priority.Descriptor instead.
+func (Priority) EnumDescriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{0}
+}
+
+type TaskStatus int32
+
+const (
+	TaskStatus_TASK_STATUS_UNSPECIFIED TaskStatus = 0
+	TaskStatus_TASK_STATUS_TODO        TaskStatus = 1
+	TaskStatus_TASK_STATUS_IN_PROGRESS TaskStatus = 2
+	TaskStatus_TASK_STATUS_REVIEW      TaskStatus = 3
+	TaskStatus_TASK_STATUS_DONE        TaskStatus = 4
+	TaskStatus_TASK_STATUS_CANCELLED   TaskStatus = 5
+)
+
+// Enum value maps for TaskStatus.
+var (
+	TaskStatus_name = map[int32]string{
+		0: "TASK_STATUS_UNSPECIFIED",
+		1: "TASK_STATUS_TODO",
+		2: "TASK_STATUS_IN_PROGRESS",
+		3: "TASK_STATUS_REVIEW",
+		4: "TASK_STATUS_DONE",
+		5: "TASK_STATUS_CANCELLED",
+	}
+	TaskStatus_value = map[string]int32{
+		"TASK_STATUS_UNSPECIFIED": 0,
+		"TASK_STATUS_TODO":        1,
+		"TASK_STATUS_IN_PROGRESS": 2,
+		"TASK_STATUS_REVIEW":      3,
+		"TASK_STATUS_DONE":        4,
+		"TASK_STATUS_CANCELLED":   5,
+	}
+)
+
+func (x TaskStatus) Enum() *TaskStatus {
+	p := new(TaskStatus)
+	*p = x
+	return p
+}
+
+func (x TaskStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_proto_enumTypes[1].Descriptor()
+}
+
+func (TaskStatus) Type() protoreflect.EnumType {
+	return &file_db_proto_enumTypes[1]
+}
+
+func (x TaskStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskStatus.Descriptor instead.
+func (TaskStatus) EnumDescriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{1}
+}
+
+type DeleteMode int32
+
+const (
+	DeleteMode_DELETE_MODE_ARCHIVE DeleteMode = 0
+	DeleteMode_DELETE_MODE_CASCADE DeleteMode = 1
+)
+
+// Enum value maps for DeleteMode.
+var (
+	DeleteMode_name = map[int32]string{
+		0: "DELETE_MODE_ARCHIVE",
+		1: "DELETE_MODE_CASCADE",
+	}
+	DeleteMode_value = map[string]int32{
+		"DELETE_MODE_ARCHIVE": 0,
+		"DELETE_MODE_CASCADE": 1,
+	}
+)
+
+func (x DeleteMode) Enum() *DeleteMode {
+	p := new(DeleteMode)
+	*p = x
+	return p
+}
+
+func (x DeleteMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_db_proto_enumTypes[2].Descriptor()
+}
+
+func (DeleteMode) Type() protoreflect.EnumType {
+	return &file_db_proto_enumTypes[2]
+}
+
+func (x DeleteMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteMode.Descriptor instead.
+func (DeleteMode) EnumDescriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{2}
+}
+
 type Empty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
-	mi := &file_db_proto_msgTypes[0]
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_db_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{0}
+}
+
+type TaskId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskId) Reset() {
+	*x = TaskId{}
+	mi := &file_db_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskId) ProtoMessage() {}
+
+func (x *TaskId) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskId.ProtoReflect.Descriptor instead.
+func (*TaskId) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TaskId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type TaskItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Completed        bool                   `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Priority         Priority               `protobuf:"varint,7,opt,name=priority,proto3,enum=db.Priority" json:"priority,omitempty"`
+	DueAt            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Tags             []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	ParentId         int64                  `protobuf:"varint,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Recurrence       string                 `protobuf:"bytes,11,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	NextOccurrenceAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=next_occurrence_at,json=nextOccurrenceAt,proto3" json:"next_occurrence_at,omitempty"`
+	ProjectId        int64                  `protobuf:"varint,13,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Status           TaskStatus             `protobuf:"varint,14,opt,name=status,proto3,enum=db.TaskStatus" json:"status,omitempty"`
+	StatusChangedAt  *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`
+	DeletedAt        *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	ArchivedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	Version          int64                  `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TaskItem) Reset() {
+	*x = TaskItem{}
+	mi := &file_db_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskItem) ProtoMessage() {}
+
+func (x *TaskItem) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskItem.ProtoReflect.Descriptor instead.
+func (*TaskItem) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaskItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaskItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskItem) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *TaskItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskItem) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *TaskItem) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_NONE
+}
+
+func (x *TaskItem) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *TaskItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaskItem) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *TaskItem) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *TaskItem) GetNextOccurrenceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOccurrenceAt
+	}
+	return nil
+}
+
+func (x *TaskItem) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *TaskItem) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *TaskItem) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
+func (x *TaskItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TaskItem) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *TaskItem) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type TaskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Completed      bool                   `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	Priority       Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=db.Priority" json:"priority,omitempty"`
+	DueAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	ParentId       *int64                 `protobuf:"varint,6,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Recurrence     string                 `protobuf:"bytes,7,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	ProjectId      *int64                 `protobuf:"varint,8,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TaskRequest) Reset() {
+	*x = TaskRequest{}
+	mi := &file_db_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRequest) ProtoMessage() {}
+
+func (x *TaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRequest.ProtoReflect.Descriptor instead.
+func (*TaskRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskRequest) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *TaskRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_NONE
+}
+
+func (x *TaskRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *TaskRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *TaskRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *TaskRequest) GetProjectId() int64 {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return 0
+}
+
+func (x *TaskRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type CreateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *TaskItem              `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Replayed      bool                   `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_db_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTaskResponse) GetTask() *TaskItem {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *CreateTaskResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+type EditTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Priority      *Priority              `protobuf:"varint,4,opt,name=priority,proto3,enum=db.Priority,oneof" json:"priority,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Recurrence    string                 `protobuf:"bytes,6,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	ProjectId     *int64                 `protobuf:"varint,7,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	Version       int64                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditTaskRequest) Reset() {
+	*x = EditTaskRequest{}
+	mi := &file_db_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditTaskRequest) ProtoMessage() {}
+
+func (x *EditTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditTaskRequest.ProtoReflect.Descriptor instead.
+func (*EditTaskRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EditTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EditTaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EditTaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EditTaskRequest) GetPriority() Priority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return Priority_PRIORITY_NONE
+}
+
+func (x *EditTaskRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *EditTaskRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *EditTaskRequest) GetProjectId() int64 {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return 0
+}
+
+func (x *EditTaskRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *TaskItem              `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_db_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateTaskRequest) GetTask() *TaskItem {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_db_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteTaskRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type CompleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cascade       bool                   `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskRequest) Reset() {
+	*x = CompleteTaskRequest{}
+	mi := &file_db_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskRequest) ProtoMessage() {}
+
+func (x *CompleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CompleteTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CompleteTaskRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type ArchiveBeforeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveBeforeRequest) Reset() {
+	*x = ArchiveBeforeRequest{}
+	mi := &file_db_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveBeforeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveBeforeRequest) ProtoMessage() {}
+
+func (x *ArchiveBeforeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveBeforeRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveBeforeRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArchiveBeforeRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type ArchiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archived      int64                  `protobuf:"varint,1,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveResponse) Reset() {
+	*x = ArchiveResponse{}
+	mi := &file_db_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveResponse) ProtoMessage() {}
+
+func (x *ArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ArchiveResponse) GetArchived() int64 {
+	if x != nil {
+		return x.Archived
+	}
+	return 0
+}
+
+type TransitionTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        TaskStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=db.TaskStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionTaskRequest) Reset() {
+	*x = TransitionTaskRequest{}
+	mi := &file_db_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionTaskRequest) ProtoMessage() {}
+
+func (x *TransitionTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionTaskRequest.ProtoReflect.Descriptor instead.
+func (*TransitionTaskRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransitionTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransitionTaskRequest) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+type SubtasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recursive     bool                   `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubtasksRequest) Reset() {
+	*x = SubtasksRequest{}
+	mi := &file_db_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubtasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubtasksRequest) ProtoMessage() {}
+
+func (x *SubtasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubtasksRequest.ProtoReflect.Descriptor instead.
+func (*SubtasksRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SubtasksRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubtasksRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type MoveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTaskRequest) Reset() {
+	*x = MoveTaskRequest{}
+	mi := &file_db_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskRequest) ProtoMessage() {}
+
+func (x *MoveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskRequest.ProtoReflect.Descriptor instead.
+func (*MoveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MoveTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveTaskRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type DependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	BlockedById   int64                  `protobuf:"varint,2,opt,name=blocked_by_id,json=blockedById,proto3" json:"blocked_by_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyRequest) Reset() {
+	*x = DependencyRequest{}
+	mi := &file_db_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyRequest) ProtoMessage() {}
+
+func (x *DependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyRequest.ProtoReflect.Descriptor instead.
+func (*DependencyRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DependencyRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *DependencyRequest) GetBlockedById() int64 {
+	if x != nil {
+		return x.BlockedById
+	}
+	return 0
+}
+
+type DueRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DueRangeRequest) Reset() {
+	*x = DueRangeRequest{}
+	mi := &file_db_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DueRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueRangeRequest) ProtoMessage() {}
+
+func (x *DueRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueRangeRequest.ProtoReflect.Descriptor instead.
+func (*DueRangeRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DueRangeRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DueRangeRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type TagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagRequest) Reset() {
+	*x = TagRequest{}
+	mi := &file_db_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagRequest) ProtoMessage() {}
+
+func (x *TagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagRequest.ProtoReflect.Descriptor instead.
+func (*TagRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TagRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *TagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type TagFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	MatchAll      bool                   `protobuf:"varint,2,opt,name=match_all,json=matchAll,proto3" json:"match_all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagFilterRequest) Reset() {
+	*x = TagFilterRequest{}
+	mi := &file_db_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagFilterRequest) ProtoMessage() {}
+
+func (x *TagFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagFilterRequest.ProtoReflect.Descriptor instead.
+func (*TagFilterRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TagFilterRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TagFilterRequest) GetMatchAll() bool {
+	if x != nil {
+		return x.MatchAll
+	}
+	return false
+}
+
+type TagItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagItem) Reset() {
+	*x = TagItem{}
+	mi := &file_db_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagItem) ProtoMessage() {}
+
+func (x *TagItem) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagItem.ProtoReflect.Descriptor instead.
+func (*TagItem) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TagItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagItem) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type TagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagItem             `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsResponse) Reset() {
+	*x = TagsResponse{}
+	mi := &file_db_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsResponse) ProtoMessage() {}
+
+func (x *TagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsResponse.ProtoReflect.Descriptor instead.
+func (*TagsResponse) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TagsResponse) GetTags() []*TagItem {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskResponse) Reset() {
+	*x = TaskResponse{}
+	mi := &file_db_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskResponse) ProtoMessage() {}
+
+func (x *TaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskResponse.ProtoReflect.Descriptor instead.
+func (*TaskResponse) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TaskResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TaskResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TaskItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *TaskItem              `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskItemResponse) Reset() {
+	*x = TaskItemResponse{}
+	mi := &file_db_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskItemResponse) ProtoMessage() {}
+
+func (x *TaskItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskItemResponse.ProtoReflect.Descriptor instead.
+func (*TaskItemResponse) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TaskItemResponse) GetTask() *TaskItem {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type TasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*TaskItem            `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TasksResponse) Reset() {
+	*x = TasksResponse{}
+	mi := &file_db_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TasksResponse) ProtoMessage() {}
+
+func (x *TasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TasksResponse.ProtoReflect.Descriptor instead.
+func (*TasksResponse) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TasksResponse) GetTasks() []*TaskItem {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *TasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type PageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
+	mi := &file_db_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRequest) ProtoMessage() {}
+
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PageRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PageRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksRequest) Reset() {
+	*x = SearchTasksRequest{}
+	mi := &file_db_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksRequest) ProtoMessage() {}
+
+func (x *SearchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksRequest.ProtoReflect.Descriptor instead.
+func (*SearchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchTasksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *TaskItem              `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Rank          float64                `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet       string                 `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_db_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SearchHit) GetTask() *TaskItem {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *SearchHit) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type SearchTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksResponse) Reset() {
+	*x = SearchTasksResponse{}
+	mi := &file_db_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksResponse) ProtoMessage() {}
+
+func (x *SearchTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksResponse.ProtoReflect.Descriptor instead.
+func (*SearchTasksResponse) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchTasksResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type TaskFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             string                 `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	Completed     *bool                  `protobuf:"varint,2,opt,name=completed,proto3,oneof" json:"completed,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Expression    string                 `protobuf:"bytes,5,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskFilter) Reset() {
+	*x = TaskFilter{}
+	mi := &file_db_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFilter) ProtoMessage() {}
+
+func (x *TaskFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFilter.ProtoReflect.Descriptor instead.
+func (*TaskFilter) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TaskFilter) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *TaskFilter) GetCompleted() bool {
+	if x != nil && x.Completed != nil {
+		return *x.Completed
+	}
+	return false
+}
+
+func (x *TaskFilter) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type SortField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Desc          bool                   `protobuf:"varint,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortField) Reset() {
+	*x = SortField{}
+	mi := &file_db_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortField) ProtoMessage() {}
+
+func (x *SortField) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortField.ProtoReflect.Descriptor instead.
+func (*SortField) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SortField) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SortField) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+type ListTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        *TaskFilter            `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort          []*SortField           `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_db_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetFilter() *TaskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type ProjectId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectId) Reset() {
+	*x = ProjectId{}
+	mi := &file_db_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectId) ProtoMessage() {}
+
+func (x *ProjectId) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectId.ProtoReflect.Descriptor instead.
+func (*ProjectId) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProjectId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ProjectItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectItem) Reset() {
+	*x = ProjectItem{}
+	mi := &file_db_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectItem) ProtoMessage() {}
+
+func (x *ProjectItem) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectItem.ProtoReflect.Descriptor instead.
+func (*ProjectItem) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProjectItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProjectItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProjectItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProjectItem) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+type ProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectRequest) Reset() {
+	*x = ProjectRequest{}
+	mi := &file_db_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectRequest) ProtoMessage() {}
+
+func (x *ProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectRequest.ProtoReflect.Descriptor instead.
+func (*ProjectRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type EditProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditProjectRequest) Reset() {
+	*x = EditProjectRequest{}
+	mi := &file_db_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditProjectRequest) ProtoMessage() {}
+
+func (x *EditProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditProjectRequest.ProtoReflect.Descriptor instead.
+func (*EditProjectRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EditProjectRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EditProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EditProjectRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DeleteProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mode          DeleteMode             `protobuf:"varint,2,opt,name=mode,proto3,enum=db.DeleteMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_db_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteProjectRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteProjectRequest) GetMode() DeleteMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DeleteMode_DELETE_MODE_ARCHIVE
+}
+
+type ListProjectsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeArchived bool                   `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_db_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListProjectsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ProjectItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *ProjectItem           `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectItemResponse) Reset() {
+	*x = ProjectItemResponse{}
+	mi := &file_db_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Empty) String() string {
+func (x *ProjectItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*ProjectItemResponse) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[0]
+func (x *ProjectItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -53,33 +2326,85 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ProjectItemResponse.ProtoReflect.Descriptor instead.
+func (*ProjectItemResponse) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{36}
 }
 
-type TaskId struct {
+func (x *ProjectItemResponse) GetProject() *ProjectItem {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type ProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*ProjectItem         `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectsResponse) Reset() {
+	*x = ProjectsResponse{}
+	mi := &file_db_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectsResponse) ProtoMessage() {}
+
+func (x *ProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ProjectsResponse) GetProjects() []*ProjectItem {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type ViewId struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskId) Reset() {
-	*x = TaskId{}
-	mi := &file_db_proto_msgTypes[1]
+func (x *ViewId) Reset() {
+	*x = ViewId{}
+	mi := &file_db_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskId) String() string {
+func (x *ViewId) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskId) ProtoMessage() {}
+func (*ViewId) ProtoMessage() {}
 
-func (x *TaskId) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[1]
+func (x *ViewId) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -90,45 +2415,52 @@ func (x *TaskId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskId.ProtoReflect.Descriptor instead.
-func (*TaskId) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ViewId.ProtoReflect.Descriptor instead.
+func (*ViewId) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *TaskId) GetId() int64 {
+func (x *ViewId) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type TaskItem struct {
+func (x *ViewId) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type ViewItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Completed     bool                   `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Filter        *TaskFilter            `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort          []*SortField           `protobuf:"bytes,5,rep,name=sort,proto3" json:"sort,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskItem) Reset() {
-	*x = TaskItem{}
-	mi := &file_db_proto_msgTypes[2]
+func (x *ViewItem) Reset() {
+	*x = ViewItem{}
+	mi := &file_db_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskItem) String() string {
+func (x *ViewItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskItem) ProtoMessage() {}
+func (*ViewItem) ProtoMessage() {}
 
-func (x *TaskItem) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[2]
+func (x *ViewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -139,77 +2471,78 @@ func (x *TaskItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskItem.ProtoReflect.Descriptor instead.
-func (*TaskItem) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ViewItem.ProtoReflect.Descriptor instead.
+func (*ViewItem) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *TaskItem) GetId() int64 {
+func (x *ViewItem) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *TaskItem) GetTitle() string {
+func (x *ViewItem) GetOwner() string {
 	if x != nil {
-		return x.Title
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *TaskItem) GetDescription() string {
+func (x *ViewItem) GetName() string {
 	if x != nil {
-		return x.Description
+		return x.Name
 	}
 	return ""
 }
 
-func (x *TaskItem) GetCompleted() bool {
+func (x *ViewItem) GetFilter() *TaskFilter {
 	if x != nil {
-		return x.Completed
+		return x.Filter
 	}
-	return false
+	return nil
 }
 
-func (x *TaskItem) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ViewItem) GetSort() []*SortField {
 	if x != nil {
-		return x.CreatedAt
+		return x.Sort
 	}
 	return nil
 }
 
-func (x *TaskItem) GetCompletedAt() *timestamppb.Timestamp {
+func (x *ViewItem) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CompletedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type TaskRequest struct {
+type ViewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Completed     bool                   `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Filter        *TaskFilter            `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort          []*SortField           `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskRequest) Reset() {
-	*x = TaskRequest{}
-	mi := &file_db_proto_msgTypes[3]
+func (x *ViewRequest) Reset() {
+	*x = ViewRequest{}
+	mi := &file_db_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskRequest) String() string {
+func (x *ViewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskRequest) ProtoMessage() {}
+func (*ViewRequest) ProtoMessage() {}
 
-func (x *TaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[3]
+func (x *ViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,56 +2553,65 @@ func (x *TaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskRequest.ProtoReflect.Descriptor instead.
-func (*TaskRequest) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ViewRequest.ProtoReflect.Descriptor instead.
+func (*ViewRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *TaskRequest) GetTitle() string {
+func (x *ViewRequest) GetOwner() string {
 	if x != nil {
-		return x.Title
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *TaskRequest) GetDescription() string {
+func (x *ViewRequest) GetName() string {
 	if x != nil {
-		return x.Description
+		return x.Name
 	}
 	return ""
 }
 
-func (x *TaskRequest) GetCompleted() bool {
+func (x *ViewRequest) GetFilter() *TaskFilter {
 	if x != nil {
-		return x.Completed
+		return x.Filter
 	}
-	return false
+	return nil
 }
 
-type EditTaskRequest struct {
+func (x *ViewRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type EditViewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Filter        *TaskFilter            `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort          []*SortField           `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty"`
+	Owner         string                 `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EditTaskRequest) Reset() {
-	*x = EditTaskRequest{}
-	mi := &file_db_proto_msgTypes[4]
+func (x *EditViewRequest) Reset() {
+	*x = EditViewRequest{}
+	mi := &file_db_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditTaskRequest) String() string {
+func (x *EditViewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditTaskRequest) ProtoMessage() {}
+func (*EditViewRequest) ProtoMessage() {}
 
-func (x *EditTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[4]
+func (x *EditViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,55 +2622,68 @@ func (x *EditTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditTaskRequest.ProtoReflect.Descriptor instead.
-func (*EditTaskRequest) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use EditViewRequest.ProtoReflect.Descriptor instead.
+func (*EditViewRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *EditTaskRequest) GetId() int64 {
+func (x *EditViewRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *EditTaskRequest) GetTitle() string {
+func (x *EditViewRequest) GetName() string {
 	if x != nil {
-		return x.Title
+		return x.Name
 	}
 	return ""
 }
 
-func (x *EditTaskRequest) GetDescription() string {
+func (x *EditViewRequest) GetFilter() *TaskFilter {
 	if x != nil {
-		return x.Description
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *EditViewRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *EditViewRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
 	}
 	return ""
 }
 
-type TaskResponse struct {
+type ListViewsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskResponse) Reset() {
-	*x = TaskResponse{}
-	mi := &file_db_proto_msgTypes[5]
+func (x *ListViewsRequest) Reset() {
+	*x = ListViewsRequest{}
+	mi := &file_db_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskResponse) String() string {
+func (x *ListViewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskResponse) ProtoMessage() {}
+func (*ListViewsRequest) ProtoMessage() {}
 
-func (x *TaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[5]
+func (x *ListViewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,47 +2694,108 @@ func (x *TaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskResponse.ProtoReflect.Descriptor instead.
-func (*TaskResponse) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListViewsRequest.ProtoReflect.Descriptor instead.
+func (*ListViewsRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *TaskResponse) GetStatus() string {
+func (x *ListViewsRequest) GetOwner() string {
 	if x != nil {
-		return x.Status
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *TaskResponse) GetMessage() string {
+type ListViewTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Owner         string                 `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListViewTasksRequest) Reset() {
+	*x = ListViewTasksRequest{}
+	mi := &file_db_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListViewTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListViewTasksRequest) ProtoMessage() {}
+
+func (x *ListViewTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[43]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListViewTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListViewTasksRequest) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListViewTasksRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListViewTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListViewTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type TaskItemResponse struct {
+func (x *ListViewTasksRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type ViewItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *TaskItem              `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	View          *ViewItem              `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskItemResponse) Reset() {
-	*x = TaskItemResponse{}
-	mi := &file_db_proto_msgTypes[6]
+func (x *ViewItemResponse) Reset() {
+	*x = ViewItemResponse{}
+	mi := &file_db_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskItemResponse) String() string {
+func (x *ViewItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskItemResponse) ProtoMessage() {}
+func (*ViewItemResponse) ProtoMessage() {}
 
-func (x *TaskItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[6]
+func (x *ViewItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,40 +2806,40 @@ func (x *TaskItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskItemResponse.ProtoReflect.Descriptor instead.
-func (*TaskItemResponse) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ViewItemResponse.ProtoReflect.Descriptor instead.
+func (*ViewItemResponse) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *TaskItemResponse) GetTask() *TaskItem {
+func (x *ViewItemResponse) GetView() *ViewItem {
 	if x != nil {
-		return x.Task
+		return x.View
 	}
 	return nil
 }
 
-type TasksResponse struct {
+type ViewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*TaskItem            `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Views         []*ViewItem            `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TasksResponse) Reset() {
-	*x = TasksResponse{}
-	mi := &file_db_proto_msgTypes[7]
+func (x *ViewsResponse) Reset() {
+	*x = ViewsResponse{}
+	mi := &file_db_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TasksResponse) String() string {
+func (x *ViewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TasksResponse) ProtoMessage() {}
+func (*ViewsResponse) ProtoMessage() {}
 
-func (x *TasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_db_proto_msgTypes[7]
+func (x *ViewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_db_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,14 +2850,14 @@ func (x *TasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TasksResponse.ProtoReflect.Descriptor instead.
-func (*TasksResponse) Descriptor() ([]byte, []int) {
-	return file_db_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ViewsResponse.ProtoReflect.Descriptor instead.
+func (*ViewsResponse) Descriptor() ([]byte, []int) {
+	return file_db_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *TasksResponse) GetTasks() []*TaskItem {
+func (x *ViewsResponse) GetViews() []*ViewItem {
 	if x != nil {
-		return x.Tasks
+		return x.Views
 	}
 	return nil
 }
@@ -450,10 +2866,10 @@ var File_db_proto protoreflect.FileDescriptor
 
 const file_db_proto_rawDesc = "" +
 	"\n" +
-	"\bdb.proto\x12\x02db\x1a\x1fgoogle/protobuf/timestamp.proto\"\a\n" +
+	"\bdb.proto\x12\x02db\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\a\n" +
 	"\x05Empty\"\x18\n" +
 	"\x06TaskId\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xea\x01\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x83\x06\n" +
 	"\bTaskItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -461,36 +2877,282 @@ const file_db_proto_rawDesc = "" +
 	"\tcompleted\x18\x04 \x01(\bR\tcompleted\x129\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
-	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"c\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12(\n" +
+	"\bpriority\x18\a \x01(\x0e2\f.db.PriorityR\bpriority\x121\n" +
+	"\x06due_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x1b\n" +
+	"\tparent_id\x18\n" +
+	" \x01(\x03R\bparentId\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\v \x01(\tR\n" +
+	"recurrence\x12H\n" +
+	"\x12next_occurrence_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x10nextOccurrenceAt\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\r \x01(\x03R\tprojectId\x12&\n" +
+	"\x06status\x18\x0e \x01(\x0e2\x0e.db.TaskStatusR\x06status\x12F\n" +
+	"\x11status_changed_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12;\n" +
+	"\varchived_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12\x18\n" +
+	"\aversion\x18\x12 \x01(\x03R\aversion\"\xec\x02\n" +
 	"\vTaskRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1c\n" +
-	"\tcompleted\x18\x03 \x01(\bR\tcompleted\"Y\n" +
+	"\tcompleted\x18\x03 \x01(\bR\tcompleted\x12(\n" +
+	"\bpriority\x18\x04 \x01(\x0e2\f.db.PriorityR\bpriority\x121\n" +
+	"\x06due_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12 \n" +
+	"\tparent_id\x18\x06 \x01(\x03H\x00R\bparentId\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\a \x01(\tR\n" +
+	"recurrence\x12\"\n" +
+	"\n" +
+	"project_id\x18\b \x01(\x03H\x01R\tprojectId\x88\x01\x01\x12'\n" +
+	"\x0fidempotency_key\x18\t \x01(\tR\x0eidempotencyKeyB\f\n" +
+	"\n" +
+	"_parent_idB\r\n" +
+	"\v_project_id\"R\n" +
+	"\x12CreateTaskResponse\x12 \n" +
+	"\x04task\x18\x01 \x01(\v2\f.db.TaskItemR\x04task\x12\x1a\n" +
+	"\breplayed\x18\x02 \x01(\bR\breplayed\"\xb5\x02\n" +
 	"\x0fEditTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"@\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12-\n" +
+	"\bpriority\x18\x04 \x01(\x0e2\f.db.PriorityH\x00R\bpriority\x88\x01\x01\x121\n" +
+	"\x06due_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x06 \x01(\tR\n" +
+	"recurrence\x12\"\n" +
+	"\n" +
+	"project_id\x18\a \x01(\x03H\x01R\tprojectId\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversionB\v\n" +
+	"\t_priorityB\r\n" +
+	"\v_project_id\"r\n" +
+	"\x11UpdateTaskRequest\x12 \n" +
+	"\x04task\x18\x01 \x01(\v2\f.db.TaskItemR\x04task\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"=\n" +
+	"\x11DeleteTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"?\n" +
+	"\x13CompleteTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\"J\n" +
+	"\x14ArchiveBeforeRequest\x122\n" +
+	"\x06before\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"-\n" +
+	"\x0fArchiveResponse\x12\x1a\n" +
+	"\barchived\x18\x01 \x01(\x03R\barchived\"O\n" +
+	"\x15TransitionTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x0e.db.TaskStatusR\x06status\"?\n" +
+	"\x0fSubtasksRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\trecursive\x18\x02 \x01(\bR\trecursive\">\n" +
+	"\x0fMoveTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\"P\n" +
+	"\x11DependencyRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\"\n" +
+	"\rblocked_by_id\x18\x02 \x01(\x03R\vblockedById\"m\n" +
+	"\x0fDueRangeRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"7\n" +
+	"\n" +
+	"TagRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\"C\n" +
+	"\x10TagFilterRequest\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12\x1b\n" +
+	"\tmatch_all\x18\x02 \x01(\bR\bmatchAll\"3\n" +
+	"\aTagItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"/\n" +
+	"\fTagsResponse\x12\x1f\n" +
+	"\x04tags\x18\x01 \x03(\v2\v.db.TagItemR\x04tags\"@\n" +
 	"\fTaskResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"4\n" +
 	"\x10TaskItemResponse\x12 \n" +
-	"\x04task\x18\x01 \x01(\v2\f.db.TaskItemR\x04task\"3\n" +
+	"\x04task\x18\x01 \x01(\v2\f.db.TaskItemR\x04task\"[\n" +
 	"\rTasksResponse\x12\"\n" +
-	"\x05tasks\x18\x01 \x03(\v2\f.db.TaskItemR\x05tasks2\x8e\x03\n" +
-	"\vTaskService\x12/\n" +
+	"\x05tasks\x18\x01 \x03(\v2\f.db.TaskItemR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"I\n" +
+	"\vPageRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\\\n" +
+	"\x12SearchTasksRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"[\n" +
+	"\tSearchHit\x12 \n" +
+	"\x04task\x18\x01 \x01(\v2\f.db.TaskItemR\x04task\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\"8\n" +
+	"\x13SearchTasksResponse\x12!\n" +
+	"\x04hits\x18\x01 \x03(\v2\r.db.SearchHitR\x04hits\"\xef\x01\n" +
+	"\n" +
+	"TaskFilter\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12!\n" +
+	"\tcompleted\x18\x02 \x01(\bH\x00R\tcompleted\x88\x01\x01\x12?\n" +
+	"\rcreated_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12\x1e\n" +
 	"\n" +
-	"CreateTask\x12\x0f.db.TaskRequest\x1a\x10.db.TaskResponse\x12+\n" +
+	"expression\x18\x05 \x01(\tR\n" +
+	"expressionB\f\n" +
+	"\n" +
+	"_completed\"5\n" +
+	"\tSortField\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04desc\x18\x02 \x01(\bR\x04desc\"\x99\x01\n" +
+	"\x10ListTasksRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12&\n" +
+	"\x06filter\x18\x03 \x01(\v2\x0e.db.TaskFilterR\x06filter\x12!\n" +
+	"\x04sort\x18\x04 \x03(\v2\r.db.SortFieldR\x04sort\"\x1b\n" +
+	"\tProjectId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xcb\x01\n" +
+	"\vProjectItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\varchived_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\"F\n" +
+	"\x0eProjectRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"Z\n" +
+	"\x12EditProjectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"J\n" +
+	"\x14DeleteProjectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\"\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x0e.db.DeleteModeR\x04mode\"@\n" +
+	"\x13ListProjectsRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\"@\n" +
+	"\x13ProjectItemResponse\x12)\n" +
+	"\aproject\x18\x01 \x01(\v2\x0f.db.ProjectItemR\aproject\"?\n" +
+	"\x10ProjectsResponse\x12+\n" +
+	"\bprojects\x18\x01 \x03(\v2\x0f.db.ProjectItemR\bprojects\".\n" +
+	"\x06ViewId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\"\xca\x01\n" +
+	"\bViewItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12&\n" +
+	"\x06filter\x18\x04 \x01(\v2\x0e.db.TaskFilterR\x06filter\x12!\n" +
+	"\x04sort\x18\x05 \x03(\v2\r.db.SortFieldR\x04sort\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x82\x01\n" +
+	"\vViewRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
+	"\x06filter\x18\x03 \x01(\v2\x0e.db.TaskFilterR\x06filter\x12!\n" +
+	"\x04sort\x18\x04 \x03(\v2\r.db.SortFieldR\x04sort\"\x96\x01\n" +
+	"\x0fEditViewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
+	"\x06filter\x18\x03 \x01(\v2\x0e.db.TaskFilterR\x06filter\x12!\n" +
+	"\x04sort\x18\x04 \x03(\v2\r.db.SortFieldR\x04sort\x12\x14\n" +
+	"\x05owner\x18\x05 \x01(\tR\x05owner\"(\n" +
+	"\x10ListViewsRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\"x\n" +
+	"\x14ListViewTasksRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\"4\n" +
+	"\x10ViewItemResponse\x12 \n" +
+	"\x04view\x18\x01 \x01(\v2\f.db.ViewItemR\x04view\"3\n" +
+	"\rViewsResponse\x12\"\n" +
+	"\x05views\x18\x01 \x03(\v2\f.db.ViewItemR\x05views*l\n" +
+	"\bPriority\x12\x11\n" +
+	"\rPRIORITY_NONE\x10\x00\x12\x10\n" +
+	"\fPRIORITY_LOW\x10\x01\x12\x13\n" +
+	"\x0fPRIORITY_MEDIUM\x10\x02\x12\x11\n" +
+	"\rPRIORITY_HIGH\x10\x03\x12\x13\n" +
+	"\x0fPRIORITY_URGENT\x10\x04*\xa5\x01\n" +
+	"\n" +
+	"TaskStatus\x12\x1b\n" +
+	"\x17TASK_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TASK_STATUS_TODO\x10\x01\x12\x1b\n" +
+	"\x17TASK_STATUS_IN_PROGRESS\x10\x02\x12\x16\n" +
+	"\x12TASK_STATUS_REVIEW\x10\x03\x12\x14\n" +
+	"\x10TASK_STATUS_DONE\x10\x04\x12\x19\n" +
+	"\x15TASK_STATUS_CANCELLED\x10\x05*>\n" +
+	"\n" +
+	"DeleteMode\x12\x17\n" +
+	"\x13DELETE_MODE_ARCHIVE\x10\x00\x12\x17\n" +
+	"\x13DELETE_MODE_CASCADE\x10\x012\xb1\r\n" +
+	"\vTaskService\x125\n" +
+	"\n" +
+	"CreateTask\x12\x0f.db.TaskRequest\x1a\x16.db.CreateTaskResponse\x12+\n" +
 	"\aGetTask\x12\n" +
-	".db.TaskId\x1a\x14.db.TaskItemResponse\x121\n" +
-	"\bEditTask\x12\x13.db.EditTaskRequest\x1a\x10.db.TaskResponse\x12*\n" +
+	".db.TaskId\x1a\x14.db.TaskItemResponse\x125\n" +
+	"\bEditTask\x12\x13.db.EditTaskRequest\x1a\x14.db.TaskItemResponse\x129\n" +
+	"\n" +
+	"UpdateTask\x12\x15.db.UpdateTaskRequest\x1a\x14.db.TaskItemResponse\x125\n" +
+	"\n" +
+	"DeleteTask\x12\x15.db.DeleteTaskRequest\x1a\x10.db.TaskResponse\x12=\n" +
+	"\fCompleteTask\x12\x17.db.CompleteTaskRequest\x1a\x14.db.TaskItemResponse\x124\n" +
+	"\tListTasks\x12\x14.db.ListTasksRequest\x1a\x11.db.TasksResponse\x128\n" +
+	"\x12ListCompletedTasks\x12\x0f.db.PageRequest\x1a\x11.db.TasksResponse\x12;\n" +
+	"\x15ListNotCompletedTasks\x12\x0f.db.PageRequest\x1a\x11.db.TasksResponse\x123\n" +
+	"\x13ListTasksByPriority\x12\t.db.Empty\x1a\x11.db.TasksResponse\x120\n" +
+	"\x10ListOverdueTasks\x12\t.db.Empty\x1a\x11.db.TasksResponse\x128\n" +
+	"\x0eListDueBetween\x12\x13.db.DueRangeRequest\x1a\x11.db.TasksResponse\x12-\n" +
+	"\tAttachTag\x12\x0e.db.TagRequest\x1a\x10.db.TaskResponse\x12-\n" +
+	"\tDetachTag\x12\x0e.db.TagRequest\x1a\x10.db.TaskResponse\x12'\n" +
+	"\bListTags\x12\t.db.Empty\x1a\x10.db.TagsResponse\x12:\n" +
+	"\x0fListTasksByTags\x12\x14.db.TagFilterRequest\x1a\x11.db.TasksResponse\x126\n" +
+	"\fListSubtasks\x12\x13.db.SubtasksRequest\x1a\x11.db.TasksResponse\x121\n" +
+	"\bMoveTask\x12\x13.db.MoveTaskRequest\x1a\x10.db.TaskResponse\x128\n" +
+	"\rAddDependency\x12\x15.db.DependencyRequest\x1a\x10.db.TaskResponse\x12;\n" +
+	"\x10RemoveDependency\x12\x15.db.DependencyRequest\x1a\x10.db.TaskResponse\x12-\n" +
+	"\fListBlockers\x12\n" +
+	".db.TaskId\x1a\x11.db.TasksResponse\x12.\n" +
+	"\x0eListReadyTasks\x12\t.db.Empty\x1a\x11.db.TasksResponse\x12=\n" +
+	"\x0eTransitionTask\x12\x19.db.TransitionTaskRequest\x1a\x10.db.TaskResponse\x12*\n" +
 	"\n" +
-	"DeleteTask\x12\n" +
-	".db.TaskId\x1a\x10.db.TaskResponse\x12,\n" +
-	"\fCompleteTask\x12\n" +
+	"ReopenTask\x12\n" +
 	".db.TaskId\x1a\x10.db.TaskResponse\x12)\n" +
-	"\tListTasks\x12\t.db.Empty\x1a\x11.db.TasksResponse\x122\n" +
-	"\x12ListCompletedTasks\x12\t.db.Empty\x1a\x11.db.TasksResponse\x125\n" +
-	"\x15ListNotCompletedTasks\x12\t.db.Empty\x1a\x11.db.TasksResponseB\x11Z\x0ftodo/proto;dbpbb\x06proto3"
+	"\tListTrash\x12\t.db.Empty\x1a\x11.db.TasksResponse\x12+\n" +
+	"\vRestoreTask\x12\n" +
+	".db.TaskId\x1a\x10.db.TaskResponse\x12)\n" +
+	"\tPurgeTask\x12\n" +
+	".db.TaskId\x1a\x10.db.TaskResponse\x12+\n" +
+	"\vArchiveTask\x12\n" +
+	".db.TaskId\x1a\x10.db.TaskResponse\x12-\n" +
+	"\rUnarchiveTask\x12\n" +
+	".db.TaskId\x1a\x10.db.TaskResponse\x12G\n" +
+	"\x16ArchiveCompletedBefore\x12\x18.db.ArchiveBeforeRequest\x1a\x13.db.ArchiveResponse\x121\n" +
+	"\x11ListArchivedTasks\x12\t.db.Empty\x1a\x11.db.TasksResponse\x12>\n" +
+	"\vSearchTasks\x12\x16.db.SearchTasksRequest\x1a\x17.db.SearchTasksResponse2\xef\x02\n" +
+	"\x0eProjectService\x12<\n" +
+	"\rCreateProject\x12\x12.db.ProjectRequest\x1a\x17.db.ProjectItemResponse\x124\n" +
+	"\n" +
+	"GetProject\x12\r.db.ProjectId\x1a\x17.db.ProjectItemResponse\x127\n" +
+	"\vEditProject\x12\x16.db.EditProjectRequest\x1a\x10.db.TaskResponse\x12;\n" +
+	"\rDeleteProject\x12\x18.db.DeleteProjectRequest\x1a\x10.db.TaskResponse\x12=\n" +
+	"\fListProjects\x12\x17.db.ListProjectsRequest\x1a\x14.db.ProjectsResponse\x124\n" +
+	"\x10ListProjectTasks\x12\r.db.ProjectId\x1a\x11.db.TasksResponse2\xc2\x02\n" +
+	"\vViewService\x123\n" +
+	"\n" +
+	"CreateView\x12\x0f.db.ViewRequest\x1a\x14.db.ViewItemResponse\x12+\n" +
+	"\aGetView\x12\n" +
+	".db.ViewId\x1a\x14.db.ViewItemResponse\x121\n" +
+	"\bEditView\x12\x13.db.EditViewRequest\x1a\x10.db.TaskResponse\x12*\n" +
+	"\n" +
+	"DeleteView\x12\n" +
+	".db.ViewId\x1a\x10.db.TaskResponse\x124\n" +
+	"\tListViews\x12\x14.db.ListViewsRequest\x1a\x11.db.ViewsResponse\x12<\n" +
+	"\rListViewTasks\x12\x18.db.ListViewTasksRequest\x1a\x11.db.TasksResponseB\x11Z\x0ftodo/proto;dbpbb\x06proto3"
 
 var (
 	file_db_proto_rawDescOnce sync.Once
@@ -504,44 +3166,198 @@ func file_db_proto_rawDescGZIP() []byte {
 	return file_db_proto_rawDescData
 }
 
-var file_db_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_db_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_db_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_db_proto_goTypes = []any{
-	(*Empty)(nil),                 // 0: db.Empty
-	(*TaskId)(nil),                // 1: db.TaskId
-	(*TaskItem)(nil),              // 2: db.TaskItem
-	(*TaskRequest)(nil),           // 3: db.TaskRequest
-	(*EditTaskRequest)(nil),       // 4: db.EditTaskRequest
-	(*TaskResponse)(nil),          // 5: db.TaskResponse
-	(*TaskItemResponse)(nil),      // 6: db.TaskItemResponse
-	(*TasksResponse)(nil),         // 7: db.TasksResponse
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(Priority)(0),                 // 0: db.Priority
+	(TaskStatus)(0),               // 1: db.TaskStatus
+	(DeleteMode)(0),               // 2: db.DeleteMode
+	(*Empty)(nil),                 // 3: db.Empty
+	(*TaskId)(nil),                // 4: db.TaskId
+	(*TaskItem)(nil),              // 5: db.TaskItem
+	(*TaskRequest)(nil),           // 6: db.TaskRequest
+	(*CreateTaskResponse)(nil),    // 7: db.CreateTaskResponse
+	(*EditTaskRequest)(nil),       // 8: db.EditTaskRequest
+	(*UpdateTaskRequest)(nil),     // 9: db.UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),     // 10: db.DeleteTaskRequest
+	(*CompleteTaskRequest)(nil),   // 11: db.CompleteTaskRequest
+	(*ArchiveBeforeRequest)(nil),  // 12: db.ArchiveBeforeRequest
+	(*ArchiveResponse)(nil),       // 13: db.ArchiveResponse
+	(*TransitionTaskRequest)(nil), // 14: db.TransitionTaskRequest
+	(*SubtasksRequest)(nil),       // 15: db.SubtasksRequest
+	(*MoveTaskRequest)(nil),       // 16: db.MoveTaskRequest
+	(*DependencyRequest)(nil),     // 17: db.DependencyRequest
+	(*DueRangeRequest)(nil),       // 18: db.DueRangeRequest
+	(*TagRequest)(nil),            // 19: db.TagRequest
+	(*TagFilterRequest)(nil),      // 20: db.TagFilterRequest
+	(*TagItem)(nil),               // 21: db.TagItem
+	(*TagsResponse)(nil),          // 22: db.TagsResponse
+	(*TaskResponse)(nil),          // 23: db.TaskResponse
+	(*TaskItemResponse)(nil),      // 24: db.TaskItemResponse
+	(*TasksResponse)(nil),         // 25: db.TasksResponse
+	(*PageRequest)(nil),           // 26: db.PageRequest
+	(*SearchTasksRequest)(nil),    // 27: db.SearchTasksRequest
+	(*SearchHit)(nil),             // 28: db.SearchHit
+	(*SearchTasksResponse)(nil),   // 29: db.SearchTasksResponse
+	(*TaskFilter)(nil),            // 30: db.TaskFilter
+	(*SortField)(nil),             // 31: db.SortField
+	(*ListTasksRequest)(nil),      // 32: db.ListTasksRequest
+	(*ProjectId)(nil),             // 33: db.ProjectId
+	(*ProjectItem)(nil),           // 34: db.ProjectItem
+	(*ProjectRequest)(nil),        // 35: db.ProjectRequest
+	(*EditProjectRequest)(nil),    // 36: db.EditProjectRequest
+	(*DeleteProjectRequest)(nil),  // 37: db.DeleteProjectRequest
+	(*ListProjectsRequest)(nil),   // 38: db.ListProjectsRequest
+	(*ProjectItemResponse)(nil),   // 39: db.ProjectItemResponse
+	(*ProjectsResponse)(nil),      // 40: db.ProjectsResponse
+	(*ViewId)(nil),                // 41: db.ViewId
+	(*ViewItem)(nil),              // 42: db.ViewItem
+	(*ViewRequest)(nil),           // 43: db.ViewRequest
+	(*EditViewRequest)(nil),       // 44: db.EditViewRequest
+	(*ListViewsRequest)(nil),      // 45: db.ListViewsRequest
+	(*ListViewTasksRequest)(nil),  // 46: db.ListViewTasksRequest
+	(*ViewItemResponse)(nil),      // 47: db.ViewItemResponse
+	(*ViewsResponse)(nil),         // 48: db.ViewsResponse
+	(*timestamppb.Timestamp)(nil), // 49: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 50: google.protobuf.FieldMask
 }
 var file_db_proto_depIdxs = []int32{
-	8,  // 0: db.TaskItem.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 1: db.TaskItem.completed_at:type_name -> google.protobuf.Timestamp
-	2,  // 2: db.TaskItemResponse.task:type_name -> db.TaskItem
-	2,  // 3: db.TasksResponse.tasks:type_name -> db.TaskItem
-	3,  // 4: db.TaskService.CreateTask:input_type -> db.TaskRequest
-	1,  // 5: db.TaskService.GetTask:input_type -> db.TaskId
-	4,  // 6: db.TaskService.EditTask:input_type -> db.EditTaskRequest
-	1,  // 7: db.TaskService.DeleteTask:input_type -> db.TaskId
-	1,  // 8: db.TaskService.CompleteTask:input_type -> db.TaskId
-	0,  // 9: db.TaskService.ListTasks:input_type -> db.Empty
-	0,  // 10: db.TaskService.ListCompletedTasks:input_type -> db.Empty
-	0,  // 11: db.TaskService.ListNotCompletedTasks:input_type -> db.Empty
-	5,  // 12: db.TaskService.CreateTask:output_type -> db.TaskResponse
-	6,  // 13: db.TaskService.GetTask:output_type -> db.TaskItemResponse
-	5,  // 14: db.TaskService.EditTask:output_type -> db.TaskResponse
-	5,  // 15: db.TaskService.DeleteTask:output_type -> db.TaskResponse
-	5,  // 16: db.TaskService.CompleteTask:output_type -> db.TaskResponse
-	7,  // 17: db.TaskService.ListTasks:output_type -> db.TasksResponse
-	7,  // 18: db.TaskService.ListCompletedTasks:output_type -> db.TasksResponse
-	7,  // 19: db.TaskService.ListNotCompletedTasks:output_type -> db.TasksResponse
-	12, // [12:20] is the sub-list for method output_type
-	4,  // [4:12] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	49, // 0: db.TaskItem.created_at:type_name -> google.protobuf.Timestamp
+	49, // 1: db.TaskItem.completed_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: db.TaskItem.priority:type_name -> db.Priority
+	49, // 3: db.TaskItem.due_at:type_name -> google.protobuf.Timestamp
+	49, // 4: db.TaskItem.next_occurrence_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: db.TaskItem.status:type_name -> db.TaskStatus
+	49, // 6: db.TaskItem.status_changed_at:type_name -> google.protobuf.Timestamp
+	49, // 7: db.TaskItem.deleted_at:type_name -> google.protobuf.Timestamp
+	49, // 8: db.TaskItem.archived_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: db.TaskRequest.priority:type_name -> db.Priority
+	49, // 10: db.TaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	5,  // 11: db.CreateTaskResponse.task:type_name -> db.TaskItem
+	0,  // 12: db.EditTaskRequest.priority:type_name -> db.Priority
+	49, // 13: db.EditTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	5,  // 14: db.UpdateTaskRequest.task:type_name -> db.TaskItem
+	50, // 15: db.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	49, // 16: db.ArchiveBeforeRequest.before:type_name -> google.protobuf.Timestamp
+	1,  // 17: db.TransitionTaskRequest.status:type_name -> db.TaskStatus
+	49, // 18: db.DueRangeRequest.from:type_name -> google.protobuf.Timestamp
+	49, // 19: db.DueRangeRequest.to:type_name -> google.protobuf.Timestamp
+	21, // 20: db.TagsResponse.tags:type_name -> db.TagItem
+	5,  // 21: db.TaskItemResponse.task:type_name -> db.TaskItem
+	5,  // 22: db.TasksResponse.tasks:type_name -> db.TaskItem
+	5,  // 23: db.SearchHit.task:type_name -> db.TaskItem
+	28, // 24: db.SearchTasksResponse.hits:type_name -> db.SearchHit
+	49, // 25: db.TaskFilter.created_after:type_name -> google.protobuf.Timestamp
+	49, // 26: db.TaskFilter.created_before:type_name -> google.protobuf.Timestamp
+	30, // 27: db.ListTasksRequest.filter:type_name -> db.TaskFilter
+	31, // 28: db.ListTasksRequest.sort:type_name -> db.SortField
+	49, // 29: db.ProjectItem.created_at:type_name -> google.protobuf.Timestamp
+	49, // 30: db.ProjectItem.archived_at:type_name -> google.protobuf.Timestamp
+	2,  // 31: db.DeleteProjectRequest.mode:type_name -> db.DeleteMode
+	34, // 32: db.ProjectItemResponse.project:type_name -> db.ProjectItem
+	34, // 33: db.ProjectsResponse.projects:type_name -> db.ProjectItem
+	30, // 34: db.ViewItem.filter:type_name -> db.TaskFilter
+	31, // 35: db.ViewItem.sort:type_name -> db.SortField
+	49, // 36: db.ViewItem.created_at:type_name -> google.protobuf.Timestamp
+	30, // 37: db.ViewRequest.filter:type_name -> db.TaskFilter
+	31, // 38: db.ViewRequest.sort:type_name -> db.SortField
+	30, // 39: db.EditViewRequest.filter:type_name -> db.TaskFilter
+	31, // 40: db.EditViewRequest.sort:type_name -> db.SortField
+	42, // 41: db.ViewItemResponse.view:type_name -> db.ViewItem
+	42, // 42: db.ViewsResponse.views:type_name -> db.ViewItem
+	6,  // 43: db.TaskService.CreateTask:input_type -> db.TaskRequest
+	4,  // 44: db.TaskService.GetTask:input_type -> db.TaskId
+	8,  // 45: db.TaskService.EditTask:input_type -> db.EditTaskRequest
+	9,  // 46: db.TaskService.UpdateTask:input_type -> db.UpdateTaskRequest
+	10, // 47: db.TaskService.DeleteTask:input_type -> db.DeleteTaskRequest
+	11, // 48: db.TaskService.CompleteTask:input_type -> db.CompleteTaskRequest
+	32, // 49: db.TaskService.ListTasks:input_type -> db.ListTasksRequest
+	26, // 50: db.TaskService.ListCompletedTasks:input_type -> db.PageRequest
+	26, // 51: db.TaskService.ListNotCompletedTasks:input_type -> db.PageRequest
+	3,  // 52: db.TaskService.ListTasksByPriority:input_type -> db.Empty
+	3,  // 53: db.TaskService.ListOverdueTasks:input_type -> db.Empty
+	18, // 54: db.TaskService.ListDueBetween:input_type -> db.DueRangeRequest
+	19, // 55: db.TaskService.AttachTag:input_type -> db.TagRequest
+	19, // 56: db.TaskService.DetachTag:input_type -> db.TagRequest
+	3,  // 57: db.TaskService.ListTags:input_type -> db.Empty
+	20, // 58: db.TaskService.ListTasksByTags:input_type -> db.TagFilterRequest
+	15, // 59: db.TaskService.ListSubtasks:input_type -> db.SubtasksRequest
+	16, // 60: db.TaskService.MoveTask:input_type -> db.MoveTaskRequest
+	17, // 61: db.TaskService.AddDependency:input_type -> db.DependencyRequest
+	17, // 62: db.TaskService.RemoveDependency:input_type -> db.DependencyRequest
+	4,  // 63: db.TaskService.ListBlockers:input_type -> db.TaskId
+	3,  // 64: db.TaskService.ListReadyTasks:input_type -> db.Empty
+	14, // 65: db.TaskService.TransitionTask:input_type -> db.TransitionTaskRequest
+	4,  // 66: db.TaskService.ReopenTask:input_type -> db.TaskId
+	3,  // 67: db.TaskService.ListTrash:input_type -> db.Empty
+	4,  // 68: db.TaskService.RestoreTask:input_type -> db.TaskId
+	4,  // 69: db.TaskService.PurgeTask:input_type -> db.TaskId
+	4,  // 70: db.TaskService.ArchiveTask:input_type -> db.TaskId
+	4,  // 71: db.TaskService.UnarchiveTask:input_type -> db.TaskId
+	12, // 72: db.TaskService.ArchiveCompletedBefore:input_type -> db.ArchiveBeforeRequest
+	3,  // 73: db.TaskService.ListArchivedTasks:input_type -> db.Empty
+	27, // 74: db.TaskService.SearchTasks:input_type -> db.SearchTasksRequest
+	35, // 75: db.ProjectService.CreateProject:input_type -> db.ProjectRequest
+	33, // 76: db.ProjectService.GetProject:input_type -> db.ProjectId
+	36, // 77: db.ProjectService.EditProject:input_type -> db.EditProjectRequest
+	37, // 78: db.ProjectService.DeleteProject:input_type -> db.DeleteProjectRequest
+	38, // 79: db.ProjectService.ListProjects:input_type -> db.ListProjectsRequest
+	33, // 80: db.ProjectService.ListProjectTasks:input_type -> db.ProjectId
+	43, // 81: db.ViewService.CreateView:input_type -> db.ViewRequest
+	41, // 82: db.ViewService.GetView:input_type -> db.ViewId
+	44, // 83: db.ViewService.EditView:input_type -> db.EditViewRequest
+	41, // 84: db.ViewService.DeleteView:input_type -> db.ViewId
+	45, // 85: db.ViewService.ListViews:input_type -> db.ListViewsRequest
+	46, // 86: db.ViewService.ListViewTasks:input_type -> db.ListViewTasksRequest
+	7,  // 87: db.TaskService.CreateTask:output_type -> db.CreateTaskResponse
+	24, // 88: db.TaskService.GetTask:output_type -> db.TaskItemResponse
+	24, // 89: db.TaskService.EditTask:output_type -> db.TaskItemResponse
+	24, // 90: db.TaskService.UpdateTask:output_type -> db.TaskItemResponse
+	23, // 91: db.TaskService.DeleteTask:output_type -> db.TaskResponse
+	24, // 92: db.TaskService.CompleteTask:output_type -> db.TaskItemResponse
+	25, // 93: db.TaskService.ListTasks:output_type -> db.TasksResponse
+	25, // 94: db.TaskService.ListCompletedTasks:output_type -> db.TasksResponse
+	25, // 95: db.TaskService.ListNotCompletedTasks:output_type -> db.TasksResponse
+	25, // 96: db.TaskService.ListTasksByPriority:output_type -> db.TasksResponse
+	25, // 97: db.TaskService.ListOverdueTasks:output_type -> db.TasksResponse
+	25, // 98: db.TaskService.ListDueBetween:output_type -> db.TasksResponse
+	23, // 99: db.TaskService.AttachTag:output_type -> db.TaskResponse
+	23, // 100: db.TaskService.DetachTag:output_type -> db.TaskResponse
+	22, // 101: db.TaskService.ListTags:output_type -> db.TagsResponse
+	25, // 102: db.TaskService.ListTasksByTags:output_type -> db.TasksResponse
+	25, // 103: db.TaskService.ListSubtasks:output_type -> db.TasksResponse
+	23, // 104: db.TaskService.MoveTask:output_type -> db.TaskResponse
+	23, // 105: db.TaskService.AddDependency:output_type -> db.TaskResponse
+	23, // 106: db.TaskService.RemoveDependency:output_type -> db.TaskResponse
+	25, // 107: db.TaskService.ListBlockers:output_type -> db.TasksResponse
+	25, // 108: db.TaskService.ListReadyTasks:output_type -> db.TasksResponse
+	23, // 109: db.TaskService.TransitionTask:output_type -> db.TaskResponse
+	23, // 110: db.TaskService.ReopenTask:output_type -> db.TaskResponse
+	25, // 111: db.TaskService.ListTrash:output_type -> db.TasksResponse
+	23, // 112: db.TaskService.RestoreTask:output_type -> db.TaskResponse
+	23, // 113: db.TaskService.PurgeTask:output_type -> db.TaskResponse
+	23, // 114: db.TaskService.ArchiveTask:output_type -> db.TaskResponse
+	23, // 115: db.TaskService.UnarchiveTask:output_type -> db.TaskResponse
+	13, // 116: db.TaskService.ArchiveCompletedBefore:output_type -> db.ArchiveResponse
+	25, // 117: db.TaskService.ListArchivedTasks:output_type -> db.TasksResponse
+	29, // 118: db.TaskService.SearchTasks:output_type -> db.SearchTasksResponse
+	39, // 119: db.ProjectService.CreateProject:output_type -> db.ProjectItemResponse
+	39, // 120: db.ProjectService.GetProject:output_type -> db.ProjectItemResponse
+	23, // 121: db.ProjectService.EditProject:output_type -> db.TaskResponse
+	23, // 122: db.ProjectService.DeleteProject:output_type -> db.TaskResponse
+	40, // 123: db.ProjectService.ListProjects:output_type -> db.ProjectsResponse
+	25, // 124: db.ProjectService.ListProjectTasks:output_type -> db.TasksResponse
+	47, // 125: db.ViewService.CreateView:output_type -> db.ViewItemResponse
+	47, // 126: db.ViewService.GetView:output_type -> db.ViewItemResponse
+	23, // 127: db.ViewService.EditView:output_type -> db.TaskResponse
+	23, // 128: db.ViewService.DeleteView:output_type -> db.TaskResponse
+	48, // 129: db.ViewService.ListViews:output_type -> db.ViewsResponse
+	25, // 130: db.ViewService.ListViewTasks:output_type -> db.TasksResponse
+	87, // [87:131] is the sub-list for method output_type
+	43, // [43:87] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_db_proto_init() }
@@ -549,18 +3365,22 @@ func file_db_proto_init() {
 	if File_db_proto != nil {
 		return
 	}
+	file_db_proto_msgTypes[3].OneofWrappers = []any{}
+	file_db_proto_msgTypes[5].OneofWrappers = []any{}
+	file_db_proto_msgTypes[27].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_db_proto_rawDesc), len(file_db_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      3,
+			NumMessages:   46,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_db_proto_goTypes,
 		DependencyIndexes: file_db_proto_depIdxs,
+		EnumInfos:         file_db_proto_enumTypes,
 		MessageInfos:      file_db_proto_msgTypes,
 	}.Build()
 	File_db_proto = out.File
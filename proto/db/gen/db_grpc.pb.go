@@ -19,28 +19,76 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TaskService_CreateTask_FullMethodName            = "/db.TaskService/CreateTask"
-	TaskService_GetTask_FullMethodName               = "/db.TaskService/GetTask"
-	TaskService_EditTask_FullMethodName              = "/db.TaskService/EditTask"
-	TaskService_DeleteTask_FullMethodName            = "/db.TaskService/DeleteTask"
-	TaskService_CompleteTask_FullMethodName          = "/db.TaskService/CompleteTask"
-	TaskService_ListTasks_FullMethodName             = "/db.TaskService/ListTasks"
-	TaskService_ListCompletedTasks_FullMethodName    = "/db.TaskService/ListCompletedTasks"
-	TaskService_ListNotCompletedTasks_FullMethodName = "/db.TaskService/ListNotCompletedTasks"
+	TaskService_CreateTask_FullMethodName             = "/db.TaskService/CreateTask"
+	TaskService_GetTask_FullMethodName                = "/db.TaskService/GetTask"
+	TaskService_EditTask_FullMethodName               = "/db.TaskService/EditTask"
+	TaskService_UpdateTask_FullMethodName             = "/db.TaskService/UpdateTask"
+	TaskService_DeleteTask_FullMethodName             = "/db.TaskService/DeleteTask"
+	TaskService_CompleteTask_FullMethodName           = "/db.TaskService/CompleteTask"
+	TaskService_ListTasks_FullMethodName              = "/db.TaskService/ListTasks"
+	TaskService_ListCompletedTasks_FullMethodName     = "/db.TaskService/ListCompletedTasks"
+	TaskService_ListNotCompletedTasks_FullMethodName  = "/db.TaskService/ListNotCompletedTasks"
+	TaskService_ListTasksByPriority_FullMethodName    = "/db.TaskService/ListTasksByPriority"
+	TaskService_ListOverdueTasks_FullMethodName       = "/db.TaskService/ListOverdueTasks"
+	TaskService_ListDueBetween_FullMethodName         = "/db.TaskService/ListDueBetween"
+	TaskService_AttachTag_FullMethodName              = "/db.TaskService/AttachTag"
+	TaskService_DetachTag_FullMethodName              = "/db.TaskService/DetachTag"
+	TaskService_ListTags_FullMethodName               = "/db.TaskService/ListTags"
+	TaskService_ListTasksByTags_FullMethodName        = "/db.TaskService/ListTasksByTags"
+	TaskService_ListSubtasks_FullMethodName           = "/db.TaskService/ListSubtasks"
+	TaskService_MoveTask_FullMethodName               = "/db.TaskService/MoveTask"
+	TaskService_AddDependency_FullMethodName          = "/db.TaskService/AddDependency"
+	TaskService_RemoveDependency_FullMethodName       = "/db.TaskService/RemoveDependency"
+	TaskService_ListBlockers_FullMethodName           = "/db.TaskService/ListBlockers"
+	TaskService_ListReadyTasks_FullMethodName         = "/db.TaskService/ListReadyTasks"
+	TaskService_TransitionTask_FullMethodName         = "/db.TaskService/TransitionTask"
+	TaskService_ReopenTask_FullMethodName             = "/db.TaskService/ReopenTask"
+	TaskService_ListTrash_FullMethodName              = "/db.TaskService/ListTrash"
+	TaskService_RestoreTask_FullMethodName            = "/db.TaskService/RestoreTask"
+	TaskService_PurgeTask_FullMethodName              = "/db.TaskService/PurgeTask"
+	TaskService_ArchiveTask_FullMethodName            = "/db.TaskService/ArchiveTask"
+	TaskService_UnarchiveTask_FullMethodName          = "/db.TaskService/UnarchiveTask"
+	TaskService_ArchiveCompletedBefore_FullMethodName = "/db.TaskService/ArchiveCompletedBefore"
+	TaskService_ListArchivedTasks_FullMethodName      = "/db.TaskService/ListArchivedTasks"
+	TaskService_SearchTasks_FullMethodName            = "/db.TaskService/SearchTasks"
 )
 
 // TaskServiceClient is the client API for TaskService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TaskServiceClient interface {
-	CreateTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	CreateTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error)
 	GetTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskItemResponse, error)
-	EditTask(ctx context.Context, in *EditTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
-	DeleteTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error)
-	CompleteTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error)
-	ListTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error)
-	ListCompletedTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error)
-	ListNotCompletedTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error)
+	EditTask(ctx context.Context, in *EditTaskRequest, opts ...grpc.CallOption) (*TaskItemResponse, error)
+	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*TaskItemResponse, error)
+	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*TaskItemResponse, error)
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*TasksResponse, error)
+	ListCompletedTasks(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*TasksResponse, error)
+	ListNotCompletedTasks(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*TasksResponse, error)
+	ListTasksByPriority(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error)
+	ListOverdueTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error)
+	ListDueBetween(ctx context.Context, in *DueRangeRequest, opts ...grpc.CallOption) (*TasksResponse, error)
+	AttachTag(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	DetachTag(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	ListTags(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TagsResponse, error)
+	ListTasksByTags(ctx context.Context, in *TagFilterRequest, opts ...grpc.CallOption) (*TasksResponse, error)
+	ListSubtasks(ctx context.Context, in *SubtasksRequest, opts ...grpc.CallOption) (*TasksResponse, error)
+	MoveTask(ctx context.Context, in *MoveTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	AddDependency(ctx context.Context, in *DependencyRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	RemoveDependency(ctx context.Context, in *DependencyRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	ListBlockers(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TasksResponse, error)
+	ListReadyTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error)
+	TransitionTask(ctx context.Context, in *TransitionTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	ReopenTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error)
+	ListTrash(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error)
+	RestoreTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error)
+	PurgeTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error)
+	ArchiveTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error)
+	UnarchiveTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error)
+	ArchiveCompletedBefore(ctx context.Context, in *ArchiveBeforeRequest, opts ...grpc.CallOption) (*ArchiveResponse, error)
+	ListArchivedTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error)
+	SearchTasks(ctx context.Context, in *SearchTasksRequest, opts ...grpc.CallOption) (*SearchTasksResponse, error)
 }
 
 type taskServiceClient struct {
@@ -51,9 +99,9 @@ func NewTaskServiceClient(cc grpc.ClientConnInterface) TaskServiceClient {
 	return &taskServiceClient{cc}
 }
 
-func (c *taskServiceClient) CreateTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+func (c *taskServiceClient) CreateTask(ctx context.Context, in *TaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TaskResponse)
+	out := new(CreateTaskResponse)
 	err := c.cc.Invoke(ctx, TaskService_CreateTask_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -71,9 +119,9 @@ func (c *taskServiceClient) GetTask(ctx context.Context, in *TaskId, opts ...grp
 	return out, nil
 }
 
-func (c *taskServiceClient) EditTask(ctx context.Context, in *EditTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+func (c *taskServiceClient) EditTask(ctx context.Context, in *EditTaskRequest, opts ...grpc.CallOption) (*TaskItemResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TaskResponse)
+	out := new(TaskItemResponse)
 	err := c.cc.Invoke(ctx, TaskService_EditTask_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -81,7 +129,17 @@ func (c *taskServiceClient) EditTask(ctx context.Context, in *EditTaskRequest, o
 	return out, nil
 }
 
-func (c *taskServiceClient) DeleteTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error) {
+func (c *taskServiceClient) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*TaskItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskItemResponse)
+	err := c.cc.Invoke(ctx, TaskService_UpdateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TaskResponse)
 	err := c.cc.Invoke(ctx, TaskService_DeleteTask_FullMethodName, in, out, cOpts...)
@@ -91,9 +149,9 @@ func (c *taskServiceClient) DeleteTask(ctx context.Context, in *TaskId, opts ...
 	return out, nil
 }
 
-func (c *taskServiceClient) CompleteTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error) {
+func (c *taskServiceClient) CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*TaskItemResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TaskResponse)
+	out := new(TaskItemResponse)
 	err := c.cc.Invoke(ctx, TaskService_CompleteTask_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -101,7 +159,7 @@ func (c *taskServiceClient) CompleteTask(ctx context.Context, in *TaskId, opts .
 	return out, nil
 }
 
-func (c *taskServiceClient) ListTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error) {
+func (c *taskServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*TasksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TasksResponse)
 	err := c.cc.Invoke(ctx, TaskService_ListTasks_FullMethodName, in, out, cOpts...)
@@ -111,7 +169,7 @@ func (c *taskServiceClient) ListTasks(ctx context.Context, in *Empty, opts ...gr
 	return out, nil
 }
 
-func (c *taskServiceClient) ListCompletedTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error) {
+func (c *taskServiceClient) ListCompletedTasks(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*TasksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TasksResponse)
 	err := c.cc.Invoke(ctx, TaskService_ListCompletedTasks_FullMethodName, in, out, cOpts...)
@@ -121,7 +179,7 @@ func (c *taskServiceClient) ListCompletedTasks(ctx context.Context, in *Empty, o
 	return out, nil
 }
 
-func (c *taskServiceClient) ListNotCompletedTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error) {
+func (c *taskServiceClient) ListNotCompletedTasks(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*TasksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TasksResponse)
 	err := c.cc.Invoke(ctx, TaskService_ListNotCompletedTasks_FullMethodName, in, out, cOpts...)
@@ -131,18 +189,272 @@ func (c *taskServiceClient) ListNotCompletedTasks(ctx context.Context, in *Empty
 	return out, nil
 }
 
+func (c *taskServiceClient) ListTasksByPriority(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTasksByPriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListOverdueTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListOverdueTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListDueBetween(ctx context.Context, in *DueRangeRequest, opts ...grpc.CallOption) (*TasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListDueBetween_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) AttachTag(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_AttachTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DetachTag(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_DetachTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTags(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTasksByTags(ctx context.Context, in *TagFilterRequest, opts ...grpc.CallOption) (*TasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTasksByTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListSubtasks(ctx context.Context, in *SubtasksRequest, opts ...grpc.CallOption) (*TasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListSubtasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) MoveTask(ctx context.Context, in *MoveTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_MoveTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) AddDependency(ctx context.Context, in *DependencyRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_AddDependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RemoveDependency(ctx context.Context, in *DependencyRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_RemoveDependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListBlockers(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListBlockers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListReadyTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListReadyTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) TransitionTask(ctx context.Context, in *TransitionTaskRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_TransitionTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReopenTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_ReopenTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTrash(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RestoreTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_RestoreTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) PurgeTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_PurgeTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ArchiveTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_ArchiveTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UnarchiveTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_UnarchiveTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ArchiveCompletedBefore(ctx context.Context, in *ArchiveBeforeRequest, opts ...grpc.CallOption) (*ArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveResponse)
+	err := c.cc.Invoke(ctx, TaskService_ArchiveCompletedBefore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListArchivedTasks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListArchivedTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) SearchTasks(ctx context.Context, in *SearchTasksRequest, opts ...grpc.CallOption) (*SearchTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_SearchTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
 type TaskServiceServer interface {
-	CreateTask(context.Context, *TaskRequest) (*TaskResponse, error)
+	CreateTask(context.Context, *TaskRequest) (*CreateTaskResponse, error)
 	GetTask(context.Context, *TaskId) (*TaskItemResponse, error)
-	EditTask(context.Context, *EditTaskRequest) (*TaskResponse, error)
-	DeleteTask(context.Context, *TaskId) (*TaskResponse, error)
-	CompleteTask(context.Context, *TaskId) (*TaskResponse, error)
-	ListTasks(context.Context, *Empty) (*TasksResponse, error)
-	ListCompletedTasks(context.Context, *Empty) (*TasksResponse, error)
-	ListNotCompletedTasks(context.Context, *Empty) (*TasksResponse, error)
+	EditTask(context.Context, *EditTaskRequest) (*TaskItemResponse, error)
+	UpdateTask(context.Context, *UpdateTaskRequest) (*TaskItemResponse, error)
+	DeleteTask(context.Context, *DeleteTaskRequest) (*TaskResponse, error)
+	CompleteTask(context.Context, *CompleteTaskRequest) (*TaskItemResponse, error)
+	ListTasks(context.Context, *ListTasksRequest) (*TasksResponse, error)
+	ListCompletedTasks(context.Context, *PageRequest) (*TasksResponse, error)
+	ListNotCompletedTasks(context.Context, *PageRequest) (*TasksResponse, error)
+	ListTasksByPriority(context.Context, *Empty) (*TasksResponse, error)
+	ListOverdueTasks(context.Context, *Empty) (*TasksResponse, error)
+	ListDueBetween(context.Context, *DueRangeRequest) (*TasksResponse, error)
+	AttachTag(context.Context, *TagRequest) (*TaskResponse, error)
+	DetachTag(context.Context, *TagRequest) (*TaskResponse, error)
+	ListTags(context.Context, *Empty) (*TagsResponse, error)
+	ListTasksByTags(context.Context, *TagFilterRequest) (*TasksResponse, error)
+	ListSubtasks(context.Context, *SubtasksRequest) (*TasksResponse, error)
+	MoveTask(context.Context, *MoveTaskRequest) (*TaskResponse, error)
+	AddDependency(context.Context, *DependencyRequest) (*TaskResponse, error)
+	RemoveDependency(context.Context, *DependencyRequest) (*TaskResponse, error)
+	ListBlockers(context.Context, *TaskId) (*TasksResponse, error)
+	ListReadyTasks(context.Context, *Empty) (*TasksResponse, error)
+	TransitionTask(context.Context, *TransitionTaskRequest) (*TaskResponse, error)
+	ReopenTask(context.Context, *TaskId) (*TaskResponse, error)
+	ListTrash(context.Context, *Empty) (*TasksResponse, error)
+	RestoreTask(context.Context, *TaskId) (*TaskResponse, error)
+	PurgeTask(context.Context, *TaskId) (*TaskResponse, error)
+	ArchiveTask(context.Context, *TaskId) (*TaskResponse, error)
+	UnarchiveTask(context.Context, *TaskId) (*TaskResponse, error)
+	ArchiveCompletedBefore(context.Context, *ArchiveBeforeRequest) (*ArchiveResponse, error)
+	ListArchivedTasks(context.Context, *Empty) (*TasksResponse, error)
+	SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -153,30 +465,102 @@ type TaskServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedTaskServiceServer struct{}
 
-func (UnimplementedTaskServiceServer) CreateTask(context.Context, *TaskRequest) (*TaskResponse, error) {
+func (UnimplementedTaskServiceServer) CreateTask(context.Context, *TaskRequest) (*CreateTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
 }
 func (UnimplementedTaskServiceServer) GetTask(context.Context, *TaskId) (*TaskItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
 }
-func (UnimplementedTaskServiceServer) EditTask(context.Context, *EditTaskRequest) (*TaskResponse, error) {
+func (UnimplementedTaskServiceServer) EditTask(context.Context, *EditTaskRequest) (*TaskItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditTask not implemented")
 }
-func (UnimplementedTaskServiceServer) DeleteTask(context.Context, *TaskId) (*TaskResponse, error) {
+func (UnimplementedTaskServiceServer) UpdateTask(context.Context, *UpdateTaskRequest) (*TaskItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteTask(context.Context, *DeleteTaskRequest) (*TaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
 }
-func (UnimplementedTaskServiceServer) CompleteTask(context.Context, *TaskId) (*TaskResponse, error) {
+func (UnimplementedTaskServiceServer) CompleteTask(context.Context, *CompleteTaskRequest) (*TaskItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompleteTask not implemented")
 }
-func (UnimplementedTaskServiceServer) ListTasks(context.Context, *Empty) (*TasksResponse, error) {
+func (UnimplementedTaskServiceServer) ListTasks(context.Context, *ListTasksRequest) (*TasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
-func (UnimplementedTaskServiceServer) ListCompletedTasks(context.Context, *Empty) (*TasksResponse, error) {
+func (UnimplementedTaskServiceServer) ListCompletedTasks(context.Context, *PageRequest) (*TasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCompletedTasks not implemented")
 }
-func (UnimplementedTaskServiceServer) ListNotCompletedTasks(context.Context, *Empty) (*TasksResponse, error) {
+func (UnimplementedTaskServiceServer) ListNotCompletedTasks(context.Context, *PageRequest) (*TasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListNotCompletedTasks not implemented")
 }
+func (UnimplementedTaskServiceServer) ListTasksByPriority(context.Context, *Empty) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasksByPriority not implemented")
+}
+func (UnimplementedTaskServiceServer) ListOverdueTasks(context.Context, *Empty) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOverdueTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) ListDueBetween(context.Context, *DueRangeRequest) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDueBetween not implemented")
+}
+func (UnimplementedTaskServiceServer) AttachTag(context.Context, *TagRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachTag not implemented")
+}
+func (UnimplementedTaskServiceServer) DetachTag(context.Context, *TagRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachTag not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTags(context.Context, *Empty) (*TagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTasksByTags(context.Context, *TagFilterRequest) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasksByTags not implemented")
+}
+func (UnimplementedTaskServiceServer) ListSubtasks(context.Context, *SubtasksRequest) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubtasks not implemented")
+}
+func (UnimplementedTaskServiceServer) MoveTask(context.Context, *MoveTaskRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTask not implemented")
+}
+func (UnimplementedTaskServiceServer) AddDependency(context.Context, *DependencyRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDependency not implemented")
+}
+func (UnimplementedTaskServiceServer) RemoveDependency(context.Context, *DependencyRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDependency not implemented")
+}
+func (UnimplementedTaskServiceServer) ListBlockers(context.Context, *TaskId) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockers not implemented")
+}
+func (UnimplementedTaskServiceServer) ListReadyTasks(context.Context, *Empty) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReadyTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) TransitionTask(context.Context, *TransitionTaskRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ReopenTask(context.Context, *TaskId) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTrash(context.Context, *Empty) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedTaskServiceServer) RestoreTask(context.Context, *TaskId) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTask not implemented")
+}
+func (UnimplementedTaskServiceServer) PurgeTask(context.Context, *TaskId) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ArchiveTask(context.Context, *TaskId) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTask not implemented")
+}
+func (UnimplementedTaskServiceServer) UnarchiveTask(context.Context, *TaskId) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ArchiveCompletedBefore(context.Context, *ArchiveBeforeRequest) (*ArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCompletedBefore not implemented")
+}
+func (UnimplementedTaskServiceServer) ListArchivedTasks(context.Context, *Empty) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTasks not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -252,8 +636,26 @@ func _TaskService_EditTask_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TaskId)
+	in := new(DeleteTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -265,13 +667,13 @@ func _TaskService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec f
 		FullMethod: TaskService_DeleteTask_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).DeleteTask(ctx, req.(*TaskId))
+		return srv.(TaskServiceServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _TaskService_CompleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TaskId)
+	in := new(CompleteTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -283,13 +685,13 @@ func _TaskService_CompleteTask_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: TaskService_CompleteTask_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).CompleteTask(ctx, req.(*TaskId))
+		return srv.(TaskServiceServer).CompleteTask(ctx, req.(*CompleteTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _TaskService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(ListTasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -301,13 +703,13 @@ func _TaskService_ListTasks_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: TaskService_ListTasks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).ListTasks(ctx, req.(*Empty))
+		return srv.(TaskServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _TaskService_ListCompletedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(PageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -319,13 +721,13 @@ func _TaskService_ListCompletedTasks_Handler(srv interface{}, ctx context.Contex
 		FullMethod: TaskService_ListCompletedTasks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).ListCompletedTasks(ctx, req.(*Empty))
+		return srv.(TaskServiceServer).ListCompletedTasks(ctx, req.(*PageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _TaskService_ListNotCompletedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(PageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -337,49 +739,1143 @@ func _TaskService_ListNotCompletedTasks_Handler(srv interface{}, ctx context.Con
 		FullMethod: TaskService_ListNotCompletedTasks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).ListNotCompletedTasks(ctx, req.(*Empty))
+		return srv.(TaskServiceServer).ListNotCompletedTasks(ctx, req.(*PageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TaskService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "db.TaskService",
-	HandlerType: (*TaskServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTask",
-			Handler:    _TaskService_CreateTask_Handler,
-		},
-		{
-			MethodName: "GetTask",
-			Handler:    _TaskService_GetTask_Handler,
-		},
-		{
-			MethodName: "EditTask",
-			Handler:    _TaskService_EditTask_Handler,
-		},
-		{
+func _TaskService_ListTasksByPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTasksByPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTasksByPriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTasksByPriority(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListOverdueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListOverdueTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListOverdueTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListOverdueTasks(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListDueBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DueRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListDueBetween(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListDueBetween_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListDueBetween(ctx, req.(*DueRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_AttachTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).AttachTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_AttachTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AttachTag(ctx, req.(*TagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DetachTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DetachTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DetachTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DetachTag(ctx, req.(*TagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTags(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTasksByTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTasksByTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTasksByTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTasksByTags(ctx, req.(*TagFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListSubtasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListSubtasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListSubtasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListSubtasks(ctx, req.(*SubtasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_MoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).MoveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_MoveTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).MoveTask(ctx, req.(*MoveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_AddDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).AddDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_AddDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddDependency(ctx, req.(*DependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RemoveDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RemoveDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RemoveDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RemoveDependency(ctx, req.(*DependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListBlockers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListBlockers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListBlockers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListBlockers(ctx, req.(*TaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListReadyTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListReadyTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListReadyTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListReadyTasks(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_TransitionTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).TransitionTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_TransitionTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).TransitionTask(ctx, req.(*TransitionTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ReopenTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ReopenTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ReopenTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReopenTask(ctx, req.(*TaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTrash(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RestoreTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RestoreTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RestoreTask(ctx, req.(*TaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_PurgeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).PurgeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_PurgeTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).PurgeTask(ctx, req.(*TaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ArchiveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ArchiveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ArchiveTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ArchiveTask(ctx, req.(*TaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UnarchiveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UnarchiveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UnarchiveTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UnarchiveTask(ctx, req.(*TaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ArchiveCompletedBefore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveBeforeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ArchiveCompletedBefore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ArchiveCompletedBefore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ArchiveCompletedBefore(ctx, req.(*ArchiveBeforeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListArchivedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListArchivedTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListArchivedTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListArchivedTasks(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_SearchTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).SearchTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_SearchTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).SearchTasks(ctx, req.(*SearchTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db.TaskService",
+	HandlerType: (*TaskServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTask",
+			Handler:    _TaskService_CreateTask_Handler,
+		},
+		{
+			MethodName: "GetTask",
+			Handler:    _TaskService_GetTask_Handler,
+		},
+		{
+			MethodName: "EditTask",
+			Handler:    _TaskService_EditTask_Handler,
+		},
+		{
+			MethodName: "UpdateTask",
+			Handler:    _TaskService_UpdateTask_Handler,
+		},
+		{
 			MethodName: "DeleteTask",
 			Handler:    _TaskService_DeleteTask_Handler,
 		},
 		{
-			MethodName: "CompleteTask",
-			Handler:    _TaskService_CompleteTask_Handler,
+			MethodName: "CompleteTask",
+			Handler:    _TaskService_CompleteTask_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _TaskService_ListTasks_Handler,
+		},
+		{
+			MethodName: "ListCompletedTasks",
+			Handler:    _TaskService_ListCompletedTasks_Handler,
+		},
+		{
+			MethodName: "ListNotCompletedTasks",
+			Handler:    _TaskService_ListNotCompletedTasks_Handler,
+		},
+		{
+			MethodName: "ListTasksByPriority",
+			Handler:    _TaskService_ListTasksByPriority_Handler,
+		},
+		{
+			MethodName: "ListOverdueTasks",
+			Handler:    _TaskService_ListOverdueTasks_Handler,
+		},
+		{
+			MethodName: "ListDueBetween",
+			Handler:    _TaskService_ListDueBetween_Handler,
+		},
+		{
+			MethodName: "AttachTag",
+			Handler:    _TaskService_AttachTag_Handler,
+		},
+		{
+			MethodName: "DetachTag",
+			Handler:    _TaskService_DetachTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _TaskService_ListTags_Handler,
+		},
+		{
+			MethodName: "ListTasksByTags",
+			Handler:    _TaskService_ListTasksByTags_Handler,
+		},
+		{
+			MethodName: "ListSubtasks",
+			Handler:    _TaskService_ListSubtasks_Handler,
+		},
+		{
+			MethodName: "MoveTask",
+			Handler:    _TaskService_MoveTask_Handler,
+		},
+		{
+			MethodName: "AddDependency",
+			Handler:    _TaskService_AddDependency_Handler,
+		},
+		{
+			MethodName: "RemoveDependency",
+			Handler:    _TaskService_RemoveDependency_Handler,
+		},
+		{
+			MethodName: "ListBlockers",
+			Handler:    _TaskService_ListBlockers_Handler,
+		},
+		{
+			MethodName: "ListReadyTasks",
+			Handler:    _TaskService_ListReadyTasks_Handler,
+		},
+		{
+			MethodName: "TransitionTask",
+			Handler:    _TaskService_TransitionTask_Handler,
+		},
+		{
+			MethodName: "ReopenTask",
+			Handler:    _TaskService_ReopenTask_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _TaskService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _TaskService_RestoreTask_Handler,
+		},
+		{
+			MethodName: "PurgeTask",
+			Handler:    _TaskService_PurgeTask_Handler,
+		},
+		{
+			MethodName: "ArchiveTask",
+			Handler:    _TaskService_ArchiveTask_Handler,
+		},
+		{
+			MethodName: "UnarchiveTask",
+			Handler:    _TaskService_UnarchiveTask_Handler,
+		},
+		{
+			MethodName: "ArchiveCompletedBefore",
+			Handler:    _TaskService_ArchiveCompletedBefore_Handler,
+		},
+		{
+			MethodName: "ListArchivedTasks",
+			Handler:    _TaskService_ListArchivedTasks_Handler,
+		},
+		{
+			MethodName: "SearchTasks",
+			Handler:    _TaskService_SearchTasks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db.proto",
+}
+
+const (
+	ProjectService_CreateProject_FullMethodName    = "/db.ProjectService/CreateProject"
+	ProjectService_GetProject_FullMethodName       = "/db.ProjectService/GetProject"
+	ProjectService_EditProject_FullMethodName      = "/db.ProjectService/EditProject"
+	ProjectService_DeleteProject_FullMethodName    = "/db.ProjectService/DeleteProject"
+	ProjectService_ListProjects_FullMethodName     = "/db.ProjectService/ListProjects"
+	ProjectService_ListProjectTasks_FullMethodName = "/db.ProjectService/ListProjectTasks"
+)
+
+// ProjectServiceClient is the client API for ProjectService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProjectServiceClient interface {
+	CreateProject(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*ProjectItemResponse, error)
+	GetProject(ctx context.Context, in *ProjectId, opts ...grpc.CallOption) (*ProjectItemResponse, error)
+	EditProject(ctx context.Context, in *EditProjectRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ProjectsResponse, error)
+	ListProjectTasks(ctx context.Context, in *ProjectId, opts ...grpc.CallOption) (*TasksResponse, error)
+}
+
+type projectServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProjectServiceClient(cc grpc.ClientConnInterface) ProjectServiceClient {
+	return &projectServiceClient{cc}
+}
+
+func (c *projectServiceClient) CreateProject(ctx context.Context, in *ProjectRequest, opts ...grpc.CallOption) (*ProjectItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectItemResponse)
+	err := c.cc.Invoke(ctx, ProjectService_CreateProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetProject(ctx context.Context, in *ProjectId, opts ...grpc.CallOption) (*ProjectItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectItemResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) EditProject(ctx context.Context, in *EditProjectRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, ProjectService_EditProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, ProjectService_DeleteProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ProjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectsResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListProjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ListProjectTasks(ctx context.Context, in *ProjectId, opts ...grpc.CallOption) (*TasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListProjectTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectServiceServer is the server API for ProjectService service.
+// All implementations must embed UnimplementedProjectServiceServer
+// for forward compatibility.
+type ProjectServiceServer interface {
+	CreateProject(context.Context, *ProjectRequest) (*ProjectItemResponse, error)
+	GetProject(context.Context, *ProjectId) (*ProjectItemResponse, error)
+	EditProject(context.Context, *EditProjectRequest) (*TaskResponse, error)
+	DeleteProject(context.Context, *DeleteProjectRequest) (*TaskResponse, error)
+	ListProjects(context.Context, *ListProjectsRequest) (*ProjectsResponse, error)
+	ListProjectTasks(context.Context, *ProjectId) (*TasksResponse, error)
+	mustEmbedUnimplementedProjectServiceServer()
+}
+
+// UnimplementedProjectServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProjectServiceServer struct{}
+
+func (UnimplementedProjectServiceServer) CreateProject(context.Context, *ProjectRequest) (*ProjectItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+}
+func (UnimplementedProjectServiceServer) GetProject(context.Context, *ProjectId) (*ProjectItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
+}
+func (UnimplementedProjectServiceServer) EditProject(context.Context, *EditProjectRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditProject not implemented")
+}
+func (UnimplementedProjectServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (UnimplementedProjectServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
+}
+func (UnimplementedProjectServiceServer) ListProjectTasks(context.Context, *ProjectId) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectTasks not implemented")
+}
+func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
+func (UnimplementedProjectServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProjectServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProjectServiceServer will
+// result in compilation errors.
+type UnsafeProjectServiceServer interface {
+	mustEmbedUnimplementedProjectServiceServer()
+}
+
+func RegisterProjectServiceServer(s grpc.ServiceRegistrar, srv ProjectServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProjectServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProjectService_ServiceDesc, srv)
+}
+
+func _ProjectService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_CreateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CreateProject(ctx, req.(*ProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProject(ctx, req.(*ProjectId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_EditProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).EditProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_EditProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).EditProject(ctx, req.(*EditProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_DeleteProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListProjectTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListProjectTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListProjectTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListProjectTasks(ctx, req.(*ProjectId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProjectService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db.ProjectService",
+	HandlerType: (*ProjectServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProject",
+			Handler:    _ProjectService_CreateProject_Handler,
+		},
+		{
+			MethodName: "GetProject",
+			Handler:    _ProjectService_GetProject_Handler,
 		},
 		{
-			MethodName: "ListTasks",
-			Handler:    _TaskService_ListTasks_Handler,
+			MethodName: "EditProject",
+			Handler:    _ProjectService_EditProject_Handler,
 		},
 		{
-			MethodName: "ListCompletedTasks",
-			Handler:    _TaskService_ListCompletedTasks_Handler,
+			MethodName: "DeleteProject",
+			Handler:    _ProjectService_DeleteProject_Handler,
 		},
 		{
-			MethodName: "ListNotCompletedTasks",
-			Handler:    _TaskService_ListNotCompletedTasks_Handler,
+			MethodName: "ListProjects",
+			Handler:    _ProjectService_ListProjects_Handler,
+		},
+		{
+			MethodName: "ListProjectTasks",
+			Handler:    _ProjectService_ListProjectTasks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "db.proto",
+}
+
+const (
+	ViewService_CreateView_FullMethodName    = "/db.ViewService/CreateView"
+	ViewService_GetView_FullMethodName       = "/db.ViewService/GetView"
+	ViewService_EditView_FullMethodName      = "/db.ViewService/EditView"
+	ViewService_DeleteView_FullMethodName    = "/db.ViewService/DeleteView"
+	ViewService_ListViews_FullMethodName     = "/db.ViewService/ListViews"
+	ViewService_ListViewTasks_FullMethodName = "/db.ViewService/ListViewTasks"
+)
+
+// ViewServiceClient is the client API for ViewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ViewServiceClient interface {
+	CreateView(ctx context.Context, in *ViewRequest, opts ...grpc.CallOption) (*ViewItemResponse, error)
+	GetView(ctx context.Context, in *ViewId, opts ...grpc.CallOption) (*ViewItemResponse, error)
+	EditView(ctx context.Context, in *EditViewRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	DeleteView(ctx context.Context, in *ViewId, opts ...grpc.CallOption) (*TaskResponse, error)
+	ListViews(ctx context.Context, in *ListViewsRequest, opts ...grpc.CallOption) (*ViewsResponse, error)
+	ListViewTasks(ctx context.Context, in *ListViewTasksRequest, opts ...grpc.CallOption) (*TasksResponse, error)
+}
+
+type viewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewViewServiceClient(cc grpc.ClientConnInterface) ViewServiceClient {
+	return &viewServiceClient{cc}
+}
+
+func (c *viewServiceClient) CreateView(ctx context.Context, in *ViewRequest, opts ...grpc.CallOption) (*ViewItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ViewItemResponse)
+	err := c.cc.Invoke(ctx, ViewService_CreateView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viewServiceClient) GetView(ctx context.Context, in *ViewId, opts ...grpc.CallOption) (*ViewItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ViewItemResponse)
+	err := c.cc.Invoke(ctx, ViewService_GetView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viewServiceClient) EditView(ctx context.Context, in *EditViewRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, ViewService_EditView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viewServiceClient) DeleteView(ctx context.Context, in *ViewId, opts ...grpc.CallOption) (*TaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, ViewService_DeleteView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viewServiceClient) ListViews(ctx context.Context, in *ListViewsRequest, opts ...grpc.CallOption) (*ViewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ViewsResponse)
+	err := c.cc.Invoke(ctx, ViewService_ListViews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viewServiceClient) ListViewTasks(ctx context.Context, in *ListViewTasksRequest, opts ...grpc.CallOption) (*TasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, ViewService_ListViewTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ViewServiceServer is the server API for ViewService service.
+// All implementations must embed UnimplementedViewServiceServer
+// for forward compatibility.
+type ViewServiceServer interface {
+	CreateView(context.Context, *ViewRequest) (*ViewItemResponse, error)
+	GetView(context.Context, *ViewId) (*ViewItemResponse, error)
+	EditView(context.Context, *EditViewRequest) (*TaskResponse, error)
+	DeleteView(context.Context, *ViewId) (*TaskResponse, error)
+	ListViews(context.Context, *ListViewsRequest) (*ViewsResponse, error)
+	ListViewTasks(context.Context, *ListViewTasksRequest) (*TasksResponse, error)
+	mustEmbedUnimplementedViewServiceServer()
+}
+
+// UnimplementedViewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedViewServiceServer struct{}
+
+func (UnimplementedViewServiceServer) CreateView(context.Context, *ViewRequest) (*ViewItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateView not implemented")
+}
+func (UnimplementedViewServiceServer) GetView(context.Context, *ViewId) (*ViewItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetView not implemented")
+}
+func (UnimplementedViewServiceServer) EditView(context.Context, *EditViewRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditView not implemented")
+}
+func (UnimplementedViewServiceServer) DeleteView(context.Context, *ViewId) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteView not implemented")
+}
+func (UnimplementedViewServiceServer) ListViews(context.Context, *ListViewsRequest) (*ViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListViews not implemented")
+}
+func (UnimplementedViewServiceServer) ListViewTasks(context.Context, *ListViewTasksRequest) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListViewTasks not implemented")
+}
+func (UnimplementedViewServiceServer) mustEmbedUnimplementedViewServiceServer() {}
+func (UnimplementedViewServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeViewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ViewServiceServer will
+// result in compilation errors.
+type UnsafeViewServiceServer interface {
+	mustEmbedUnimplementedViewServiceServer()
+}
+
+func RegisterViewServiceServer(s grpc.ServiceRegistrar, srv ViewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedViewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ViewService_ServiceDesc, srv)
+}
+
+func _ViewService_CreateView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewServiceServer).CreateView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewService_CreateView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewServiceServer).CreateView(ctx, req.(*ViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViewService_GetView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewServiceServer).GetView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewService_GetView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewServiceServer).GetView(ctx, req.(*ViewId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViewService_EditView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewServiceServer).EditView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewService_EditView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewServiceServer).EditView(ctx, req.(*EditViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViewService_DeleteView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewServiceServer).DeleteView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewService_DeleteView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewServiceServer).DeleteView(ctx, req.(*ViewId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViewService_ListViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewServiceServer).ListViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewService_ListViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewServiceServer).ListViews(ctx, req.(*ListViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ViewService_ListViewTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListViewTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewServiceServer).ListViewTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ViewService_ListViewTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewServiceServer).ListViewTasks(ctx, req.(*ListViewTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ViewService_ServiceDesc is the grpc.ServiceDesc for ViewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ViewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "db.ViewService",
+	HandlerType: (*ViewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateView",
+			Handler:    _ViewService_CreateView_Handler,
+		},
+		{
+			MethodName: "GetView",
+			Handler:    _ViewService_GetView_Handler,
+		},
+		{
+			MethodName: "EditView",
+			Handler:    _ViewService_EditView_Handler,
+		},
+		{
+			MethodName: "DeleteView",
+			Handler:    _ViewService_DeleteView_Handler,
+		},
+		{
+			MethodName: "ListViews",
+			Handler:    _ViewService_ListViews_Handler,
+		},
+		{
+			MethodName: "ListViewTasks",
+			Handler:    _ViewService_ListViewTasks_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},